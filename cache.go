@@ -0,0 +1,249 @@
+package qiita
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// CachedResponse is a response of GET request stored in a Cache.
+type CachedResponse struct {
+	ETag   string      `json:"etag"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// Cache stores responses of GET requests to revalidate them with If-None-Match header.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+}
+
+// CacheStats represents how many responses were served from the cache.
+type CacheStats struct {
+	// Hits is the number of responses served from the cache after qiita API answered 304 Not Modified.
+	Hits int64
+	// Misses is the number of GET requests whose response was not in the cache or was modified.
+	Misses int64
+}
+
+// CacheStats returns the statistics of the client's Cache.
+func (c *Client) CacheStats() CacheStats {
+	c.cacheStatsMu.Lock()
+	defer c.cacheStatsMu.Unlock()
+
+	return c.cacheStats
+}
+
+func (c *Client) countCache(hit bool) {
+	c.cacheStatsMu.Lock()
+	defer c.cacheStatsMu.Unlock()
+
+	if hit {
+		c.cacheStats.Hits++
+	} else {
+		c.cacheStats.Misses++
+	}
+}
+
+// cacheKey identifies a response by the URL and the access token which requested it.
+// The token is hashed not to be stored as it is.
+func cacheKey(req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(token[:8]) + " " + req.URL.String()
+}
+
+// cachingDoer serves responses of GET requests from the client's Cache when qiita API answers 304 Not Modified.
+func (c *Client) cachingDoer(next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			return next.Do(req)
+		}
+
+		key := cacheKey(req)
+		cached, ok := c.Cache.Get(key)
+		if ok {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+
+		resp, err := next.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok && resp.StatusCode == http.StatusNotModified {
+			c.countCache(true)
+			_ = resp.Body.Close()
+			return newCachedHTTPResponse(req, resp, cached), nil
+		}
+		c.countCache(false)
+
+		etag := resp.Header.Get("etag")
+		if resp.StatusCode != http.StatusOK || etag == "" || strings.Contains(resp.Header.Get("cache-control"), "no-store") {
+			return resp, nil
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		c.Cache.Set(key, &CachedResponse{ETag: etag, Header: resp.Header.Clone(), Body: body})
+		return resp, nil
+	})
+}
+
+// newCachedHTTPResponse builds a 200 response from the cached one.
+// Headers of the 304 response, such as rate limit and request id, take precedence over the cached ones.
+func newCachedHTTPResponse(req *http.Request, notModified *http.Response, cached *CachedResponse) *http.Response {
+	header := cached.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	for k, v := range notModified.Header {
+		header[k] = v
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
+
+// MemoryCache is a Cache which keeps a limited number of responses in memory,
+// evicting the least recently used one.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	elements map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewMemoryCache returns a MemoryCache which holds up to capacity responses.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		ll:       list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the response stored with key.
+func (m *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.elements[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).resp, true
+}
+
+// Set stores the response with key, evicting the least recently used one if the cache is full.
+func (m *MemoryCache) Set(key string, resp *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.elements[key]; ok {
+		e.Value.(*memoryCacheEntry).resp = resp
+		m.ll.MoveToFront(e)
+		return
+	}
+
+	m.elements[key] = m.ll.PushFront(&memoryCacheEntry{key: key, resp: resp})
+	for m.capacity > 0 && m.ll.Len() > m.capacity {
+		oldest := m.ll.Back()
+		m.ll.Remove(oldest)
+		delete(m.elements, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len returns the number of stored responses.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.ll.Len()
+}
+
+// DiskCache is a Cache which stores responses as files in a directory.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing responses in dir. The directory is created if it does not exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the response stored with key. Unreadable files are regarded as missing.
+func (d *DiskCache) Get(key string) (*CachedResponse, bool) {
+	b, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var resp CachedResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, false
+	}
+	return &resp, true
+}
+
+// Set stores the response with key. Failures to write are ignored since the response can be fetched again.
+func (d *DiskCache) Set(key string, resp *CachedResponse) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+
+	// write to a temporary file and rename it so that concurrent readers never see a partial file
+	tmp, err := ioutil.TempFile(d.dir, "tmp-")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	_ = os.Rename(tmp.Name(), d.path(key))
+}
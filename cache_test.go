@@ -0,0 +1,163 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+)
+
+// newETagServer returns a server which serves an item with an ETag and answers 304 to matching If-None-Match.
+// The item changes its title and ETag when version is incremented.
+func newETagServer(t *testing.T, version *int32, fullResponses *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		v := atomic.LoadInt32(version)
+		etag := fmt.Sprintf(`W/"version-%d"`, v)
+
+		w.Header().Set("rate-limit", "1000")
+		w.Header().Set("rate-remaining", "999")
+		w.Header().Set("rate-reset", "1553416334")
+		w.Header().Set("etag", etag)
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		atomic.AddInt32(fullResponses, 1)
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"id":"b4ca1773580317e7112e","title":"version %d"}`, v)))
+	}))
+}
+
+func TestClient_Cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qiita-cache")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	diskCache, err := NewDiskCache(dir)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	tests := []struct {
+		desc  string
+		cache Cache
+	}{
+		{
+			desc:  "memory",
+			cache: NewMemoryCache(10),
+		},
+		{
+			desc:  "disk",
+			cache: diskCache,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var version, fullResponses int32
+			server := newETagServer(t, &version, &fullResponses)
+			defer server.Close()
+
+			cli, err := NewClient("access_token", WithBaseURL(server.URL), WithCache(tt.cache))
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			ctx := context.Background()
+			for i := 0; i < 3; i++ {
+				item, err := cli.GetItem(ctx, "b4ca1773580317e7112e")
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+				assert.Equal(t, "version 0", item.Title)
+			}
+			assert.Equal(t, int32(1), atomic.LoadInt32(&fullResponses))
+			assert.Equal(t, CacheStats{Hits: 2, Misses: 1}, cli.CacheStats())
+			assert.Equal(t, 999, cli.RateLimit().Remaining)
+
+			atomic.AddInt32(&version, 1)
+			item, err := cli.GetItem(ctx, "b4ca1773580317e7112e")
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, "version 1", item.Title)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&fullResponses))
+			assert.Equal(t, CacheStats{Hits: 2, Misses: 2}, cli.CacheStats())
+
+			// another token must not share the cached response
+			other, err := NewClient("other_token", WithBaseURL(server.URL), WithCache(tt.cache))
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			_, err = other.GetItem(ctx, "b4ca1773580317e7112e")
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, CacheStats{Hits: 0, Misses: 1}, other.CacheStats())
+		})
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", &CachedResponse{ETag: "a"})
+	cache.Set("b", &CachedResponse{ETag: "b"})
+	_, _ = cache.Get("a")
+	cache.Set("c", &CachedResponse{ETag: "c"})
+
+	assert.Equal(t, 2, cache.Len())
+	_, ok := cache.Get("b")
+	assert.False(t, ok, "least recently used entry should be evicted")
+
+	resp, ok := cache.Get("a")
+	if assert.True(t, ok) {
+		assert.Equal(t, "a", resp.ETag)
+	}
+	resp, ok = cache.Get("c")
+	if assert.True(t, ok) {
+		assert.Equal(t, "c", resp.ETag)
+	}
+
+	cache.Set("a", &CachedResponse{ETag: "a2"})
+	resp, _ = cache.Get("a")
+	assert.Equal(t, "a2", resp.ETag)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qiita-cache")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewDiskCache(dir)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+
+	expected := &CachedResponse{ETag: `W/"etag"`, Header: http.Header{"Etag": {`W/"etag"`}}, Body: []byte(`{"id":"id"}`)}
+	cache.Set("key", expected)
+
+	resp, ok := cache.Get("key")
+	if assert.True(t, ok) {
+		assert.Equal(t, expected, resp)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if assert.Nil(t, err) {
+		assert.Equal(t, 1, len(files))
+	}
+}
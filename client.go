@@ -1,10 +1,10 @@
 package qiita
 
 import (
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 const (
@@ -20,31 +20,100 @@ type Client struct {
 	URL        *url.URL
 	HTTPClient *http.Client
 
-	AccessToken string
+	// TokenSource supplies the access token sent with each request. Requests are not authenticated if it is nil.
+	// It should not be replaced while the client is in use. Use RotatingTokenSource to rotate tokens.
+	TokenSource TokenSource
 	UserAgent   string
 
-	Logger *log.Logger
+	// Scopes are the scopes granted to the access token. Requests to endpoints requiring a scope not in Scopes
+	// fail with ErrInsufficientScope before they are sent. Scopes are not checked if it is nil.
+	Scopes []Scope
+
+	// Logger receives structured logs of requests. Logs are discarded if it is nil.
+	Logger Logger
+
+	// WaitForRateLimit makes the client block until the rate limit window resets
+	// instead of sending a request which is sure to be rejected.
+	WaitForRateLimit bool
+
+	// RateLimiter throttles requests on the client side. Requests are not throttled if it is nil.
+	RateLimiter *RateLimiter
+
+	// RetryPolicy configures retries of failed requests. Requests are not retried if it is nil.
+	RetryPolicy *RetryPolicy
+
+	// Cache stores responses of GET requests to revalidate them by ETag. Responses are not cached if it is nil.
+	Cache Cache
+
+	middlewares []Middleware
+
+	rateLimitMu sync.RWMutex
+	rateLimit   *RateLimit
+
+	cacheStatsMu sync.Mutex
+	cacheStats   CacheStats
+
+	whoamiMu    sync.Mutex
+	whoami      *AuthenticatedUser
+	whoamiToken string
 }
 
 // New returns a Client
 func New(accessToken string, logger *log.Logger) (*Client, error) {
-	baseURL, err := url.Parse(BaseURL)
+	return NewClient(accessToken, WithLogger(NewStdLogger(logger)))
+}
+
+// NewClient returns a Client configured by provided options.
+// accessToken is ignored if a TokenSource is provided by WithTokenSource.
+func NewClient(accessToken string, opts ...Option) (*Client, error) {
+	o := &options{
+		baseURL:    BaseURL,
+		httpClient: http.DefaultClient,
+		userAgent:  "qiita go-client (github.com/muiscript/qiita)",
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	baseURL, err := url.Parse(o.baseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	discardLogger := log.New(ioutil.Discard, "", 0)
+	httpClient := o.httpClient
+	if o.timeout > 0 {
+		hc := *httpClient
+		hc.Timeout = o.timeout
+		httpClient = &hc
+	}
+
+	tokenSource := o.tokenSource
+	if tokenSource == nil {
+		tokenSource = StaticTokenSource(accessToken)
+	}
+
+	logger := o.logger
 	if logger == nil {
-		logger = discardLogger
+		logger = nopLogger{}
 	}
 
 	return &Client{
 		URL:        baseURL,
-		HTTPClient: http.DefaultClient,
+		HTTPClient: httpClient,
 
-		AccessToken: accessToken,
-		UserAgent:   "qiita go-client (github.com/muiscript/qiita)",
+		TokenSource: tokenSource,
+		UserAgent:   o.userAgent,
+		Scopes:      o.scopes,
 
 		Logger: logger,
+
+		WaitForRateLimit: o.waitForRateLimit,
+		RetryPolicy:      o.retryPolicy,
+		RateLimiter:      o.rateLimiter,
+		Cache:            o.cache,
+
+		middlewares: o.middlewares,
 	}, nil
 }
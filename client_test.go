@@ -1,29 +1,35 @@
 package qiita
 
 import (
+	"context"
+	"fmt"
 	"github.com/stretchr/testify/assert"
-	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
+	stdoutLogger := log.New(os.Stdout, "", log.LstdFlags)
+
 	tests := []struct {
 		desc        string
 		accessToken string
 		logger      *log.Logger
 
 		expectedURL    string
-		expectedLogger *log.Logger
+		expectedLogger Logger
 	}{
 		{
 			desc:        "success",
 			accessToken: "access_token",
-			logger:      log.New(os.Stdout, "", log.LstdFlags),
+			logger:      stdoutLogger,
 
 			expectedURL:    BaseURL,
-			expectedLogger: log.New(os.Stdout, "", log.LstdFlags),
+			expectedLogger: &stdLogger{logger: stdoutLogger},
 		},
 		{
 			desc:        "success_with_no_logger",
@@ -31,15 +37,15 @@ func TestNew(t *testing.T) {
 			logger:      nil,
 
 			expectedURL:    BaseURL,
-			expectedLogger: log.New(ioutil.Discard, "", 0),
+			expectedLogger: nopLogger{},
 		},
 		{
 			desc:        "success_with_no_access_token",
 			accessToken: "",
-			logger:      log.New(os.Stdout, "", log.LstdFlags),
+			logger:      stdoutLogger,
 
 			expectedURL:    BaseURL,
-			expectedLogger: log.New(os.Stdout, "", log.LstdFlags),
+			expectedLogger: &stdLogger{logger: stdoutLogger},
 		},
 	}
 
@@ -51,7 +57,146 @@ func TestNew(t *testing.T) {
 			}
 
 			assert.Equal(t, cli.URL.String(), tt.expectedURL)
+			token, err := cli.TokenSource.Token(context.Background())
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, token, tt.accessToken)
 			assert.Equal(t, cli.Logger, tt.expectedLogger)
 		})
 	}
 }
+
+func TestNewClient(t *testing.T) {
+	httpClient := &http.Client{}
+	retryPolicy := DefaultRetryPolicy()
+
+	tests := []struct {
+		desc string
+		opts []Option
+
+		expectedErrString  string
+		expectedURL        string
+		expectedHTTPClient *http.Client
+		expectedUserAgent  string
+		expectedTimeout    time.Duration
+
+		expectedRetryPolicy      *RetryPolicy
+		expectedWaitForRateLimit bool
+	}{
+		{
+			desc: "success-default",
+			opts: nil,
+
+			expectedURL:        BaseURL,
+			expectedHTTPClient: http.DefaultClient,
+			expectedUserAgent:  "qiita go-client (github.com/muiscript/qiita)",
+		},
+		{
+			desc: "success-base_url",
+			opts: []Option{WithBaseURL("http://localhost:8080/api/v2")},
+
+			expectedURL:        "http://localhost:8080/api/v2",
+			expectedHTTPClient: http.DefaultClient,
+			expectedUserAgent:  "qiita go-client (github.com/muiscript/qiita)",
+		},
+		{
+			desc: "success-team",
+			opts: []Option{WithTeam("increments")},
+
+			expectedURL:        "https://increments.qiita.com/api/v2",
+			expectedHTTPClient: http.DefaultClient,
+			expectedUserAgent:  "qiita go-client (github.com/muiscript/qiita)",
+		},
+		{
+			desc: "success-http_client_and_user_agent",
+			opts: []Option{WithHTTPClient(httpClient), WithUserAgent("my-bot/1.0")},
+
+			expectedURL:        BaseURL,
+			expectedHTTPClient: httpClient,
+			expectedUserAgent:  "my-bot/1.0",
+		},
+		{
+			desc: "success-timeout",
+			opts: []Option{WithTimeout(3 * time.Second)},
+
+			expectedURL:       BaseURL,
+			expectedUserAgent: "qiita go-client (github.com/muiscript/qiita)",
+			expectedTimeout:   3 * time.Second,
+		},
+		{
+			desc: "success-retry_policy_and_wait_for_rate_limit",
+			opts: []Option{WithRetryPolicy(retryPolicy), WithWaitForRateLimit()},
+
+			expectedURL:              BaseURL,
+			expectedUserAgent:        "qiita go-client (github.com/muiscript/qiita)",
+			expectedRetryPolicy:      retryPolicy,
+			expectedWaitForRateLimit: true,
+		},
+		{
+			desc: "failure-base_url_without_scheme",
+			opts: []Option{WithBaseURL("localhost:8080")},
+
+			expectedErrString: "base URL should be http or https",
+		},
+		{
+			desc: "failure-invalid_team",
+			opts: []Option{WithTeam("Invalid Team")},
+
+			expectedErrString: "team name should consist of",
+		},
+		{
+			desc: "failure-nil_http_client",
+			opts: []Option{WithHTTPClient(nil)},
+
+			expectedErrString: "http client should not be nil",
+		},
+		{
+			desc: "failure-empty_user_agent",
+			opts: []Option{WithUserAgent("")},
+
+			expectedErrString: "user agent should not be empty",
+		},
+		{
+			desc: "failure-negative_timeout",
+			opts: []Option{WithTimeout(-time.Second)},
+
+			expectedErrString: "timeout should be positive",
+		},
+		{
+			desc: "failure-nil_retry_policy",
+			opts: []Option{WithRetryPolicy(nil)},
+
+			expectedErrString: "retry policy should not be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, err := NewClient("access_token", tt.opts...)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedURL, cli.URL.String())
+				assert.Equal(t, tt.expectedUserAgent, cli.UserAgent)
+				if tt.expectedHTTPClient != nil {
+					assert.True(t, tt.expectedHTTPClient == cli.HTTPClient)
+				}
+				assert.Equal(t, tt.expectedTimeout, cli.HTTPClient.Timeout)
+				assert.True(t, tt.expectedRetryPolicy == cli.RetryPolicy)
+				assert.Equal(t, tt.expectedWaitForRateLimit, cli.WaitForRateLimit)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+
+	// WithTimeout must not modify the shared default client.
+	assert.Equal(t, time.Duration(0), http.DefaultClient.Timeout)
+}
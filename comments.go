@@ -1,11 +1,16 @@
 package qiita
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
 	"time"
 )
 
-// Comment represents a comment on qiita item.
+// Comment represents a comment on qiita item or Qiita Team project.
 type Comment struct {
 	ID           string `json:"id"`
 	Body         string `json:"body"`
@@ -17,7 +22,7 @@ type Comment struct {
 	User *User `json:"user"`
 }
 
-// CommentDraft represents a comment to be posted on qiita item.
+// CommentDraft represents a comment to be posted on qiita item or Qiita Team project.
 type CommentDraft struct {
 	Body string `json:"body"`
 }
@@ -27,27 +32,88 @@ type CommentDraft struct {
 // GET /api/v2/comments/:comment_id
 // document: http://qiita.com/api/v2/docs#get-apiv2commentscomment_id
 func (c *Client) GetComment(ctx context.Context, commentID string) (*Comment, error) {
-	// TODO: implement
-	return nil, nil
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("comments", commentID), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	code, _, err := c.doRequest(req, &comment)
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("comment with id '%s' not found: %w", commentID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &comment, nil
 }
 
-// UpdateComment update the comment having provided commentID.
+// UpdateComment updates the comment having provided commentID.
 // This method requires authentication.
 //
 // PATCH /api/v2/comments/:comment_id
 // document: https://qiita.com/api/v2/docs#patch-apiv2commentscomment_id
-func (c *Client) UpdateComment(ctx context.Context, commentID string, body string) error {
-	// TODO: implement
-	return nil
+func (c *Client) UpdateComment(ctx context.Context, commentID string, body string) (*Comment, error) {
+	commentDraft := &CommentDraft{Body: body}
+	bodyBytes, err := json.Marshal(commentDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPatch, path.Join("comments", commentID), nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	code, _, err := c.doRequest(req, &comment)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("comment with id '%s' not found: %w", commentID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &comment, nil
 }
 
-// DeleteComment delete the comment having provided commentID.
+// DeleteComment deletes the comment having provided commentID.
 // This method requires authentication.
 //
 // DELETE /api/v2/comments/:comment_id
 // document: http://qiita.com/api/v2/docs#delete-apiv2commentscomment_id
 func (c *Client) DeleteComment(ctx context.Context, commentID string) error {
-	// TODO: implement
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("comments", commentID), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may not be the author of comment with id '%s': %w", commentID, err)
+		case http.StatusNotFound:
+			return fmt.Errorf("comment with id '%s' not found: %w", commentID, err)
+		default:
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -57,7 +123,25 @@ func (c *Client) DeleteComment(ctx context.Context, commentID string) error {
 // PUT /api/v2/comments/:comment_id/thank
 // document: http://qiita.com/api/v2/docs#put-apiv2commentscomment_idthank
 func (c *Client) ThankComment(ctx context.Context, commentID string) error {
-	// TODO: implement
+	req, err := c.newRequest(ctx, http.MethodPut, path.Join("comments", commentID, "thank"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have thanked comment with id '%s': %w", commentID, err)
+		case http.StatusNotFound:
+			return fmt.Errorf("comment with id '%s' not found: %w", commentID, err)
+		default:
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -67,6 +151,22 @@ func (c *Client) ThankComment(ctx context.Context, commentID string) error {
 // DELETE /api/v2/comments/:comment_id/thank
 // document: http://qiita.com/api/v2/docs#delete-apiv2commentscomment_idthank
 func (c *Client) UnthankComment(ctx context.Context, commentID string) error {
-	// TODO: implement
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("comments", commentID, "thank"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("comment with id '%s' not found or not thanked: %w", commentID, err)
+		default:
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,427 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_GetComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "comments", "GetComment")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedID          string
+		expectedBody        string
+		expectedCreatedAt   time.Time
+		expectedUserID      string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/comments/87788bd04277521c7a66",
+			expectedID:          "87788bd04277521c7a66",
+			expectedBody:        "@alt ありがとうございます！",
+			expectedCreatedAt:   time.Date(2019, 2, 16, 11, 58, 8, 0, time.FixedZone("JST", 9*60*60)),
+			expectedUserID:      "muiscript",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/comments/nonexistent",
+			expectedErrString:   "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			comment, err := cli.GetComment(context.Background(), tt.inputCommentID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, comment.ID)
+				assert.True(t, strings.Contains(comment.Body, tt.expectedBody))
+				assert.True(t, comment.CreatedAt.Equal(tt.expectedCreatedAt))
+				assert.Equal(t, tt.expectedUserID, comment.User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_UpdateComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "comments", "UpdateComment")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+		inputBody      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod       string
+		expectedRequestPath  string
+		expectedRawQuery     string
+		expectedErrString    string
+		expectedBody         string
+		expectedRenderedBody string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+			inputBody:      "updated comment",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:       http.MethodPatch,
+			expectedRequestPath:  "/comments/87788bd04277521c7a66",
+			expectedBody:         "updated comment\n",
+			expectedRenderedBody: "<p>updated comment</p>\n",
+		},
+		{
+			desc:           "failure-empty_body",
+			inputCommentID: "87788bd04277521c7a66",
+			inputBody:      "",
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/comments/87788bd04277521c7a66",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "87788bd04277521c7a66",
+			inputBody:      "updated comment",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/comments/87788bd04277521c7a66",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+			inputBody:      "updated comment",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/comments/nonexistent",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:           "failure-no_permission",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+			inputBody:      "updated comment",
+
+			mockResponseHeaderFile: "no_permission-header",
+			mockResponseBodyFile:   "no_permission-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2",
+			expectedErrString:   "forbidden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			comment, err := cli.UpdateComment(context.Background(), tt.inputCommentID, tt.inputBody)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.inputCommentID, comment.ID)
+				assert.Equal(t, tt.expectedBody, comment.Body)
+				assert.Equal(t, tt.expectedRenderedBody, comment.RenderedBody)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "comments", "DeleteComment")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/87788bd04277521c7a66",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "87788bd04277521c7a66",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/87788bd04277521c7a66",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/nonexistent",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:           "failure-no_permission",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "no_permission-header",
+			mockResponseBodyFile:   "no_permission-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2",
+			expectedErrString:   "forbidden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.DeleteComment(context.Background(), tt.inputCommentID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_ThankComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "comments", "ThankComment")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+		},
+		{
+			desc:           "failure-already_thanked",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "already_thanked-header",
+			mockResponseBodyFile:   "already_thanked-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/comments/nonexistent/thank",
+			expectedErrString:   "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.ThankComment(context.Background(), tt.inputCommentID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_UnthankComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "comments", "UnthankComment")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+		},
+		{
+			desc:           "failure-not_thanked",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "not_thanked-header",
+			mockResponseBodyFile:   "not_thanked-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+			expectedErrString:   "not thanked",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "c41321e4d3cfe95fabc2",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/c41321e4d3cfe95fabc2/thank",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/nonexistent/thank",
+			expectedErrString:   "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.UnthankComment(context.Background(), tt.inputCommentID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
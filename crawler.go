@@ -0,0 +1,169 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const createdDateLayout = "2006-01-02"
+
+// createdWindow is a range of item creation dates [from, to).
+type createdWindow struct {
+	from time.Time
+	to   time.Time
+}
+
+func (w createdWindow) days() int {
+	return int(w.to.Sub(w.from).Hours()/24 + 0.5)
+}
+
+func (w createdWindow) bisect() (createdWindow, createdWindow) {
+	mid := w.from.AddDate(0, 0, w.days()/2)
+	return createdWindow{from: w.from, to: mid}, createdWindow{from: mid, to: w.to}
+}
+
+// hasOrOperator reports whether query has OR as a term outside of double quotes.
+func hasOrOperator(query string) bool {
+	var term strings.Builder
+	quoted, escaped := false, false
+	for _, r := range query + " " {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && unicode.IsSpace(r):
+			if term.String() == "OR" {
+				return true
+			}
+			term.Reset()
+			continue
+		}
+		term.WriteRune(r)
+	}
+	return false
+}
+
+func (w createdWindow) query(base string) string {
+	return NewQuery().Raw(base).Created(GreaterThanOrEqual, w.from).Created(LessThan, w.to).String()
+}
+
+// ItemCrawler enumerates the items matching a search query beyond the 100 pages qiita API serves.
+// The query is split into windows of creation dates, and windows which still exceed 100 pages are bisected.
+// Items are returned from the newest window to the oldest one.
+type ItemCrawler struct {
+	client  *Client
+	query   string
+	perPage int
+
+	windows   []createdWindow
+	current   createdWindow
+	nextPage  int
+	items     []*Item
+	index     int
+	truncated bool
+	err       error
+}
+
+// CrawlItems returns an ItemCrawler which enumerates the items matching query created in [since, until).
+// query is a search query of qiita such as "tag:go". All the items are enumerated if it is empty.
+// query should not contain OR, because the qualifiers of the windows would restrict only its last alternative
+// as qiita search has no parentheses. The crawler fails with an error in that case.
+// Only the dates of since and until in their location are used, and qiita interprets them in JST.
+func (c *Client) CrawlItems(query string, since, until time.Time, perPage int) *ItemCrawler {
+	from := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location())
+	to := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, until.Location())
+	if until.After(to) {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	it := &ItemCrawler{
+		client:  c,
+		query:   query,
+		perPage: perPage,
+		index:   -1,
+	}
+	if hasOrOperator(query) {
+		it.err = errors.New("query of CrawlItems should not contain OR, which cannot be combined with the windows of creation dates")
+		return it
+	}
+	if from.Before(to) {
+		it.windows = []createdWindow{{from: from, to: to}}
+	}
+	return it
+}
+
+// Next advances the crawler to the next item, fetching the next page or window if needed.
+// It returns false when no item is left or an error occurs.
+func (it *ItemCrawler) Next(ctx context.Context) bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+
+		it.index++
+		if it.index < len(it.items) {
+			return true
+		}
+
+		if it.nextPage != 0 {
+			it.fetch(ctx, it.current, it.nextPage)
+			continue
+		}
+
+		if len(it.windows) == 0 {
+			return false
+		}
+		w := it.windows[len(it.windows)-1]
+		it.windows = it.windows[:len(it.windows)-1]
+
+		resp := it.fetch(ctx, w, PageMin)
+		if resp == nil {
+			continue
+		}
+		if resp.Truncated {
+			if w.days() > 1 {
+				older, newer := w.bisect()
+				// newer window is popped first since qiita returns newer items first
+				it.windows = append(it.windows, older, newer)
+				it.items, it.nextPage = nil, 0
+				continue
+			}
+			it.truncated = true
+		}
+	}
+}
+
+func (it *ItemCrawler) fetch(ctx context.Context, w createdWindow, page int) *ItemsResponse {
+	resp, err := it.client.getItems(ctx, w.query(it.query), page, it.perPage)
+	if err != nil {
+		it.err = err
+		return nil
+	}
+
+	it.current = w
+	it.items = resp.Items
+	it.index = -1
+	it.nextPage = resp.NextPage
+	return resp
+}
+
+// Value returns the current item.
+func (it *ItemCrawler) Value() *Item {
+	return it.items[it.index]
+}
+
+// Err returns the error which stopped the crawling, if any.
+func (it *ItemCrawler) Err() error {
+	return it.err
+}
+
+// Truncated reports whether some items were skipped because a single day had more than 100 pages of items.
+func (it *ItemCrawler) Truncated() bool {
+	return it.truncated
+}
@@ -0,0 +1,210 @@
+package qiita
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newSearchServer returns a server which serves provided items, newest first,
+// filtering them by created:>= and created:< qualifiers of the query parameter.
+// Unlike qiita API, the last page is not capped so that truncation can be tested.
+func newSearchServer(t *testing.T, items []*Item, expectedBaseQuery string) *httptest.Server {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !assert.Equal(t, "/items", req.URL.Path) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var from, to time.Time
+		var rest []string
+		for _, term := range strings.Fields(req.URL.Query().Get("query")) {
+			switch {
+			case strings.HasPrefix(term, "created:>="):
+				from, _ = time.ParseInLocation(createdDateLayout, strings.TrimPrefix(term, "created:>="), jst)
+			case strings.HasPrefix(term, "created:<"):
+				to, _ = time.ParseInLocation(createdDateLayout, strings.TrimPrefix(term, "created:<"), jst)
+			default:
+				rest = append(rest, term)
+			}
+		}
+		if !assert.Equal(t, expectedBaseQuery, strings.Join(rest, " ")) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var matched []*Item
+		for _, item := range items {
+			if !item.CreatedAt.Before(from) && item.CreatedAt.Before(to) {
+				matched = append(matched, item)
+			}
+		}
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(req.URL.Query().Get("per_page"))
+		lastPage := (len(matched) + perPage - 1) / perPage
+		if lastPage == 0 {
+			lastPage = 1
+		}
+		links := []string{fmt.Sprintf(`<https://qiita.com/api/v2/items?page=%d>; rel="last"`, lastPage)}
+		if page < lastPage {
+			links = append(links, fmt.Sprintf(`<https://qiita.com/api/v2/items?page=%d>; rel="next"`, page+1))
+		}
+
+		start, end := (page-1)*perPage, page*perPage
+		if end > len(matched) {
+			end = len(matched)
+		}
+		body, _ := json.Marshal(matched[start:end])
+
+		w.Header().Set("link", strings.Join(links, ", "))
+		w.Header().Set("total-count", strconv.Itoa(len(matched)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}))
+}
+
+// newDailyItems returns items created on consecutive days from since, newest first.
+func newDailyItems(since time.Time, days, itemsPerDay int) []*Item {
+	var items []*Item
+	for d := days - 1; d >= 0; d-- {
+		for n := itemsPerDay - 1; n >= 0; n-- {
+			items = append(items, &Item{
+				ID:        fmt.Sprintf("d%d-%d", d, n),
+				CreatedAt: since.AddDate(0, 0, d).Add(time.Duration(n) * time.Minute),
+			})
+		}
+	}
+	return items
+}
+
+func TestClient_CrawlItems(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, jst)
+
+	tests := []struct {
+		desc        string
+		query       string
+		days        int
+		itemsPerDay int
+		until       time.Time
+
+		expectedLen       int
+		expectedTruncated bool
+		expectedErrString string
+	}{
+		{
+			desc:        "success-single_window",
+			query:       "tag:go",
+			days:        3,
+			itemsPerDay: 10,
+			until:       since.AddDate(0, 0, 3),
+
+			expectedLen: 30,
+		},
+		{
+			desc:        "success-bisected",
+			query:       "tag:go",
+			days:        10,
+			itemsPerDay: 15,
+			until:       since.AddDate(0, 0, 10),
+
+			expectedLen: 150,
+		},
+		{
+			desc:        "success-partial_day_until",
+			query:       "",
+			days:        3,
+			itemsPerDay: 10,
+			until:       since.AddDate(0, 0, 1).Add(time.Hour),
+
+			expectedLen: 20,
+		},
+		{
+			desc:        "truncated-single_day",
+			query:       "tag:go",
+			days:        1,
+			itemsPerDay: 105,
+			until:       since.AddDate(0, 0, 1),
+
+			expectedLen:       100,
+			expectedTruncated: true,
+		},
+		{
+			desc:        "failure-or",
+			query:       "tag:go OR tag:rust",
+			days:        3,
+			itemsPerDay: 10,
+			until:       since.AddDate(0, 0, 3),
+
+			expectedErrString: "should not contain OR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			items := newDailyItems(since, tt.days, tt.itemsPerDay)
+			server := newSearchServer(t, items, tt.query)
+			defer server.Close()
+			cli := newPagedClient(t, server)
+
+			it := cli.CrawlItems(tt.query, since, tt.until, 1)
+			seen := make(map[string]bool)
+			var prev *Item
+			for it.Next(context.Background()) {
+				item := it.Value()
+				assert.False(t, seen[item.ID], "duplicated item %s", item.ID)
+				seen[item.ID] = true
+				if prev != nil {
+					assert.True(t, !item.CreatedAt.After(prev.CreatedAt), "items should be newest first")
+				}
+				prev = item
+			}
+
+			if tt.expectedErrString == "" {
+				assert.Nil(t, it.Err())
+			} else {
+				if !assert.NotNil(t, it.Err()) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(it.Err().Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", it.Err().Error(), tt.expectedErrString))
+			}
+			assert.Equal(t, tt.expectedLen, len(seen))
+			assert.Equal(t, tt.expectedTruncated, it.Truncated())
+		})
+	}
+}
+
+func TestHasOrOperator(t *testing.T) {
+	tests := []struct {
+		desc  string
+		query string
+
+		expected bool
+	}{
+		{desc: "empty", query: "", expected: false},
+		{desc: "no_or", query: "tag:go stocks:>10", expected: false},
+		{desc: "or", query: "tag:go OR tag:rust", expected: true},
+		{desc: "or-full_width_space", query: "tag:go　OR　tag:rust", expected: true},
+		{desc: "quoted_or", query: `"OR" title:"go OR rust"`, expected: false},
+		{desc: "escaped_quote", query: `title:"say \"hi\" OR" tag:go`, expected: false},
+		{desc: "qualifier_value", query: "tag:OR", expected: false},
+		{desc: "lower_case", query: "tag:go or tag:rust", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hasOrOperator(tt.query))
+		})
+	}
+}
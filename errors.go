@@ -0,0 +1,89 @@
+package qiita
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrNotFound is matched by an *APIError whose status is 404.
+	ErrNotFound = errors.New("qiita: not found")
+	// ErrUnauthorized is matched by an *APIError whose status is 401.
+	ErrUnauthorized = errors.New("qiita: unauthorized")
+	// ErrForbidden is matched by an *APIError whose status is 403 and which is not caused by the rate limit.
+	ErrForbidden = errors.New("qiita: forbidden")
+	// ErrRateLimited is matched by an *APIError caused by exceeding the rate limit.
+	ErrRateLimited = errors.New("qiita: rate limited")
+	// ErrInsufficientScope is matched by an *InsufficientScopeError.
+	ErrInsufficientScope = errors.New("qiita: insufficient scope")
+	// ErrConflict is matched by an error returned from ModifyItem when the item has been updated concurrently.
+	ErrConflict = errors.New("qiita: conflict")
+)
+
+// qiita API reports an exceeded rate limit with this error type.
+const errorTypeRateLimitExceeded = "rate_limit_exceeded"
+
+// APIError represents an error response returned from qiita API.
+// It can be compared with ErrNotFound, ErrUnauthorized, ErrForbidden and ErrRateLimited by errors.Is.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	RequestID  string `json:"-"`
+	Method     string `json:"-"`
+	Path       string `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	s := fmt.Sprintf("%s %s: %s (status = %d", e.Method, e.Path, msg, e.StatusCode)
+	if e.Type != "" {
+		s += fmt.Sprintf(", type = %s", e.Type)
+	}
+	if e.RequestID != "" {
+		s += fmt.Sprintf(", request id = %s", e.RequestID)
+	}
+	return s + ")"
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden && !e.isRateLimited()
+	case ErrRateLimited:
+		return e.isRateLimited()
+	default:
+		return false
+	}
+}
+
+func (e *APIError) isRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.Type == errorTypeRateLimitExceeded
+}
+
+// InsufficientScopeError is returned without sending a request when the access token lacks the scope required by the endpoint.
+// It can be compared with ErrInsufficientScope by errors.Is.
+type InsufficientScopeError struct {
+	Method   string
+	Endpoint string
+	Scope    Scope
+}
+
+func (e *InsufficientScopeError) Error() string {
+	return fmt.Sprintf("%s %s: access token is not granted the required scope '%s'", e.Method, e.Endpoint, e.Scope)
+}
+
+// Is reports whether target is ErrInsufficientScope.
+func (e *InsufficientScopeError) Is(target error) bool {
+	return target == ErrInsufficientScope
+}
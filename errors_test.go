@@ -0,0 +1,163 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"testing"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		desc     string
+		apiError *APIError
+
+		expectedNotFound     bool
+		expectedUnauthorized bool
+		expectedForbidden    bool
+		expectedRateLimited  bool
+	}{
+		{
+			desc:             "not_found",
+			apiError:         &APIError{StatusCode: http.StatusNotFound, Type: "not_found"},
+			expectedNotFound: true,
+		},
+		{
+			desc:                 "unauthorized",
+			apiError:             &APIError{StatusCode: http.StatusUnauthorized, Type: "unauthorized"},
+			expectedUnauthorized: true,
+		},
+		{
+			desc:              "forbidden",
+			apiError:          &APIError{StatusCode: http.StatusForbidden, Type: "forbidden"},
+			expectedForbidden: true,
+		},
+		{
+			desc:                "rate_limit_exceeded",
+			apiError:            &APIError{StatusCode: http.StatusForbidden, Type: "rate_limit_exceeded"},
+			expectedRateLimited: true,
+		},
+		{
+			desc:                "too_many_requests",
+			apiError:            &APIError{StatusCode: http.StatusTooManyRequests},
+			expectedRateLimited: true,
+		},
+		{
+			desc:     "internal_server_error",
+			apiError: &APIError{StatusCode: http.StatusInternalServerError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expectedNotFound, errors.Is(tt.apiError, ErrNotFound))
+			assert.Equal(t, tt.expectedUnauthorized, errors.Is(tt.apiError, ErrUnauthorized))
+			assert.Equal(t, tt.expectedForbidden, errors.Is(tt.apiError, ErrForbidden))
+			assert.Equal(t, tt.expectedRateLimited, errors.Is(tt.apiError, ErrRateLimited))
+		})
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	tests := []struct {
+		desc             string
+		mockFilesBaseDir string
+		call             func(cli *Client) error
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedSentinel    error
+		expectedStatusCode  int
+		expectedType        string
+		expectedMessage     string
+		expectedRequestID   string
+	}{
+		{
+			desc:             "get_item-not_exist",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItem"),
+			call: func(cli *Client) error {
+				_, err := cli.GetItem(context.Background(), "nonexistent")
+				return err
+			},
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/nonexistent",
+			expectedSentinel:    ErrNotFound,
+			expectedStatusCode:  http.StatusNotFound,
+			expectedType:        "not_found",
+			expectedMessage:     "Not found",
+			expectedRequestID:   "38dd249d-e222-4895-b8af-7bde309aa638",
+		},
+		{
+			desc:             "stock_item-already_stocked",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "StockItem"),
+			call: func(cli *Client) error {
+				return cli.StockItem(context.Background(), "68f6ee99a35a15ed8074")
+			},
+
+			mockResponseHeaderFile: "already_stocked-header",
+			mockResponseBodyFile:   "already_stocked-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/stock",
+			expectedSentinel:    ErrForbidden,
+			expectedStatusCode:  http.StatusForbidden,
+			expectedType:        "already_stocked",
+			expectedMessage:     "Already stocked",
+			expectedRequestID:   "84645fc4-ba1e-4093-be2a-f01bb711058e",
+		},
+		{
+			desc:             "get_authenticated_user-no_token",
+			mockFilesBaseDir: path.Join("testdata", "responses", "users", "GetAuthenticatedUser"),
+			call: func(cli *Client) error {
+				_, err := cli.GetAuthenticatedUser(context.Background())
+				return err
+			},
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/authenticated_user",
+			expectedSentinel:    ErrUnauthorized,
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedType:        "unauthorized",
+			expectedMessage:     "Unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, tt.mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			err := tt.call(cli)
+			if !assert.NotNil(t, err) {
+				t.FailNow()
+			}
+
+			assert.True(t, errors.Is(err, tt.expectedSentinel))
+
+			var apiErr *APIError
+			if !assert.True(t, errors.As(err, &apiErr)) {
+				t.FailNow()
+			}
+			assert.Equal(t, tt.expectedStatusCode, apiErr.StatusCode)
+			assert.Equal(t, tt.expectedType, apiErr.Type)
+			assert.Equal(t, tt.expectedMessage, apiErr.Message)
+			assert.Equal(t, tt.expectedMethod, apiErr.Method)
+			assert.Equal(t, tt.expectedRequestPath, apiErr.Path)
+			if tt.expectedRequestID != "" {
+				assert.Equal(t, tt.expectedRequestID, apiErr.RequestID)
+			}
+		})
+	}
+}
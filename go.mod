@@ -1,3 +1,5 @@
 module github.com/muiscript/qiita
 
+go 1.13
+
 require github.com/stretchr/testify v1.3.0
@@ -0,0 +1,187 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// Group represents a group on Qiita Team.
+type Group struct {
+	Name        string `json:"name"`
+	URLName     string `json:"url_name"`
+	Description string `json:"description"`
+	Private     bool   `json:"private"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GroupMember represents a member of a group on Qiita Team.
+type GroupMember struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GroupsResponse represents a response from qiita API which includes multiple groups.
+type GroupsResponse struct {
+	Groups     []*Group
+	PerPage    int
+	Page       int
+	FirstPage  int
+	LastPage   int
+	NextPage   int
+	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
+}
+
+func newGroupsResponse(groups []*Group, header http.Header, page, perPage int) (*GroupsResponse, error) {
+	paginationInfo, err := extractPaginationInfo(header, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupsResponse{
+		Groups:     groups,
+		PerPage:    paginationInfo.PerPage,
+		Page:       paginationInfo.Page,
+		FirstPage:  paginationInfo.FirstPage,
+		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
+		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
+	}, nil
+}
+
+// GroupMembersResponse represents a response from qiita API which includes multiple group members.
+type GroupMembersResponse struct {
+	Members    []*GroupMember
+	PerPage    int
+	Page       int
+	FirstPage  int
+	LastPage   int
+	NextPage   int
+	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
+}
+
+func newGroupMembersResponse(members []*GroupMember, header http.Header, page, perPage int) (*GroupMembersResponse, error) {
+	paginationInfo, err := extractPaginationInfo(header, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMembersResponse{
+		Members:    members,
+		PerPage:    paginationInfo.PerPage,
+		Page:       paginationInfo.Page,
+		FirstPage:  paginationInfo.FirstPage,
+		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
+		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
+	}, nil
+}
+
+// GetGroups fetches the groups of the team.
+// This method requires authentication.
+//
+// GET /api/v2/groups
+// document: http://qiita.com/api/v2/docs#get-apiv2groups
+func (c *Client) GetGroups(ctx context.Context, page, perPage int) (*GroupsResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, "groups", queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*Group
+	code, header, err := c.doRequest(req, &groups)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newGroupsResponse(groups, header, page, perPage)
+}
+
+// GetGroup fetches the group having provided urlName.
+// This method requires authentication.
+//
+// GET /api/v2/groups/:url_name
+// document: http://qiita.com/api/v2/docs#get-apiv2groupsurl_name
+func (c *Client) GetGroup(ctx context.Context, urlName string) (*Group, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("groups", urlName), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var group Group
+	code, _, err := c.doRequest(req, &group)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("group with url name '%s' not found: %w", urlName, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &group, nil
+}
+
+// GetGroupMembers fetches the members of the group having provided urlName.
+// This method requires authentication.
+//
+// GET /api/v2/groups/:url_name/members
+// document: http://qiita.com/api/v2/docs#get-apiv2groupsurl_namemembers
+func (c *Client) GetGroupMembers(ctx context.Context, urlName string, page, perPage int) (*GroupMembersResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("groups", urlName, "members"), queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*GroupMember
+	code, header, err := c.doRequest(req, &members)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("group with url name '%s' not found: %w", urlName, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newGroupMembersResponse(members, header, page, perPage)
+}
@@ -0,0 +1,313 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetGroups(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "groups", "GetGroups")
+
+	tests := []struct {
+		desc         string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedURLNames    []string
+		expectedPrivate     []bool
+		expectedPage        int
+		expectedPerPage     int
+		expectedFirstPage   int
+		expectedLastPage    int
+		expectedNextPage    int
+		expectedTotalCount  int
+	}{
+		{
+			desc:         "success",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedURLNames:    []string{"backend", "executives"},
+			expectedPrivate:     []bool{false, true},
+			expectedPage:        1,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    3,
+			expectedNextPage:    2,
+			expectedTotalCount:  6,
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-no_token",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			groupsResp, err := cli.GetGroups(context.Background(), tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var urlNames []string
+				var private []bool
+				for _, group := range groupsResp.Groups {
+					urlNames = append(urlNames, group.URLName)
+					private = append(private, group.Private)
+				}
+				assert.Equal(t, tt.expectedURLNames, urlNames)
+				assert.Equal(t, tt.expectedPrivate, private)
+				assert.Equal(t, tt.expectedPage, groupsResp.Page)
+				assert.Equal(t, tt.expectedPerPage, groupsResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, groupsResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, groupsResp.LastPage)
+				assert.Equal(t, tt.expectedNextPage, groupsResp.NextPage)
+				assert.Equal(t, tt.expectedTotalCount, groupsResp.TotalCount)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetGroup(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "groups", "GetGroup")
+
+	tests := []struct {
+		desc         string
+		inputURLName string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedName        string
+		expectedURLName     string
+		expectedDescription string
+		expectedPrivate     bool
+	}{
+		{
+			desc:         "success",
+			inputURLName: "backend",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/backend",
+			expectedName:        "バックエンド",
+			expectedURLName:     "backend",
+			expectedDescription: "バックエンドチームのグループです",
+			expectedPrivate:     false,
+		},
+		{
+			desc:         "failure-no_token",
+			inputURLName: "backend",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/backend",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:         "failure-not_exist",
+			inputURLName: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/nonexistent",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			group, err := cli.GetGroup(context.Background(), tt.inputURLName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, group.Name)
+				assert.Equal(t, tt.expectedURLName, group.URLName)
+				assert.Equal(t, tt.expectedDescription, group.Description)
+				assert.Equal(t, tt.expectedPrivate, group.Private)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetGroupMembers(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "groups", "GetGroupMembers")
+
+	tests := []struct {
+		desc         string
+		inputURLName string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedIDs         []string
+		expectedFirstEmail  string
+		expectedLastPage    int
+		expectedTotalCount  int
+	}{
+		{
+			desc:         "success",
+			inputURLName: "backend",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/backend/members",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedIDs:         []string{"muiscript", "tkdev"},
+			expectedFirstEmail:  "muiscript@example.com",
+			expectedLastPage:    5,
+			expectedTotalCount:  9,
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputURLName: "backend",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/backend/members",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-no_token",
+			inputURLName: "backend",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/backend/members",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:         "failure-not_exist",
+			inputURLName: "nonexistent",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/groups/nonexistent/members",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			membersResp, err := cli.GetGroupMembers(context.Background(), tt.inputURLName, tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var ids []string
+				for _, member := range membersResp.Members {
+					ids = append(ids, member.ID)
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+				assert.Equal(t, tt.expectedFirstEmail, membersResp.Members[0].Email)
+				assert.Equal(t, tt.expectedLastPage, membersResp.LastPage)
+				assert.Equal(t, tt.expectedTotalCount, membersResp.TotalCount)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
@@ -12,9 +12,14 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func (c *Client) newRequest(ctx context.Context, method string, relativePath string, queries map[string]string, headers map[string]string, body io.Reader) (*http.Request, error) {
+	if err := c.checkScope(method, relativePath); err != nil {
+		return nil, err
+	}
+
 	reqUrl := *c.URL
 	reqUrl.Path = path.Join(reqUrl.Path, relativePath)
 
@@ -37,8 +42,14 @@ func (c *Client) newRequest(ctx context.Context, method string, relativePath str
 		headers = make(map[string]string)
 	}
 	headers["User-Agent"] = c.UserAgent
-	if c.AccessToken != "" {
-		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.AccessToken)
+	if c.TokenSource != nil {
+		token, err := c.TokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token: %w", err)
+		}
+		if token != "" {
+			headers["Authorization"] = fmt.Sprintf("Bearer %s", token)
+		}
 	}
 	for k, v := range headers {
 		req.Header.Set(k, v)
@@ -48,23 +59,45 @@ func (c *Client) newRequest(ctx context.Context, method string, relativePath str
 }
 
 func (c *Client) doRequest(req *http.Request, body interface{}) (int, http.Header, error) {
-	resp, err := c.HTTPClient.Do(req)
+	start := time.Now()
+	resp, err := c.send(req)
 	if err != nil {
+		// the error of the transport includes the request URL, which may have an access token.
+		err = redactError(err)
+		c.logger().Error("qiita request failed", requestLogArgs(req, start, "error", err)...)
 		return 0, nil, err
 	}
-	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
-		return resp.StatusCode, resp.Header, nil
-	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
-	c.Logger.Printf("send %s request to %s\n", req.Method, c.URL.String())
+	c.updateRateLimit(resp.Header)
+	recordResponse(req.Context(), resp)
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
+		c.logger().Error("qiita request failed", requestLogArgs(req, start, "status", resp.StatusCode, "error", redactError(err))...)
 		return 0, nil, err
 	}
 
+	args := requestLogArgs(req, start,
+		"status", resp.StatusCode,
+		"bytes", len(bodyBytes),
+		"rate_remaining", resp.Header.Get("rate-remaining"),
+		"request_id", resp.Header.Get("x-request-id"),
+	)
+	switch {
+	case resp.StatusCode >= 500:
+		c.logger().Error("qiita request", args...)
+	case resp.StatusCode >= 400:
+		c.logger().Warn("qiita request", args...)
+	default:
+		c.logger().Debug("qiita request", args...)
+	}
+
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		return resp.StatusCode, resp.Header, newAPIError(req, resp, bodyBytes)
+	}
+
 	if len(bodyBytes) > 0 {
 		if err := json.Unmarshal(bodyBytes, body); err != nil {
 			return 0, nil, err
@@ -74,6 +107,31 @@ func (c *Client) doRequest(req *http.Request, body interface{}) (int, http.Heade
 	return resp.StatusCode, resp.Header, nil
 }
 
+// requestLogArgs returns the log attributes of the request with credentials redacted, followed by extra.
+func requestLogArgs(req *http.Request, start time.Time, extra ...interface{}) []interface{} {
+	args := []interface{}{
+		"method", req.Method,
+		"path", redactPath(req.URL),
+		"query", redactQuery(req.URL),
+		"duration", time.Since(start),
+	}
+	return append(args, extra...)
+}
+
+// newAPIError builds an APIError from a non-2xx response.
+// The body is decoded when it is qiita's JSON error payload and ignored otherwise.
+func newAPIError(req *http.Request, resp *http.Response, bodyBytes []byte) *APIError {
+	apiErr := &APIError{}
+	_ = json.Unmarshal(bodyBytes, apiErr)
+
+	apiErr.StatusCode = resp.StatusCode
+	apiErr.RequestID = resp.Header.Get("x-request-id")
+	apiErr.Method = req.Method
+	apiErr.Path = redactPath(req.URL)
+
+	return apiErr
+}
+
 func validatePaginationLimit(page, perPage int) error {
 	if page < PageMin || PageMax < page {
 		return fmt.Errorf("page parameter should be between %d and %d. got %d", PageMin, PageMax, page)
@@ -89,18 +147,43 @@ func extractPaginationInfo(header http.Header, page int, perPage int) (*paginati
 	if err != nil {
 		return nil, err
 	}
-	lastURL := links["last"]
-	lastPage, err := strconv.Atoi(lastURL.Query().Get("page"))
-	if err != nil {
-		return nil, err
+	// a response without the last link, such as an empty list, is regarded as the last page
+	// unless it has the next link.
+	lastPage := page
+	if lastURL, ok := links["last"]; ok {
+		lastPage, err = strconv.Atoi(lastURL.Query().Get("page"))
+		if err != nil {
+			return nil, err
+		}
+	} else if nextURL, ok := links["next"]; ok {
+		lastPage, err = strconv.Atoi(nextURL.Query().Get("page"))
+		if err != nil {
+			return nil, err
+		}
 	}
-	if lastPage > 100 {
-		lastPage = 100
+	truncated := false
+	if lastPage > PageMax {
+		lastPage = PageMax
+		truncated = true
 	}
 
-	totalCount, err := strconv.Atoi(header.Get("total-count"))
-	if err != nil {
-		return nil, err
+	var nextPage int
+	if nextURL, ok := links["next"]; ok {
+		nextPage, err = strconv.Atoi(nextURL.Query().Get("page"))
+		if err != nil {
+			return nil, err
+		}
+		if nextPage > lastPage {
+			nextPage = 0
+		}
+	}
+
+	var totalCount int
+	if v := header.Get("total-count"); v != "" {
+		totalCount, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &paginationInfo{
@@ -108,7 +191,10 @@ func extractPaginationInfo(header http.Header, page int, perPage int) (*paginati
 		PerPage:    perPage,
 		FirstPage:  1,
 		LastPage:   lastPage,
+		NextPage:   nextPage,
 		TotalCount: totalCount,
+		Truncated:  truncated,
+		RateLimit:  parseRateLimit(header),
 	}, nil
 }
 
@@ -120,6 +206,9 @@ func parseHeaderLink(header http.Header) (map[string]*url.URL, error) {
 
 	for _, link := range strings.Split(linksStr, ", ") {
 		m := rx.FindStringSubmatch(link)
+		if m == nil {
+			continue
+		}
 
 		rel := m[2]
 		linkURL, err := url.Parse(m[1])
@@ -0,0 +1,108 @@
+package qiita
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExtractPaginationInfo(t *testing.T) {
+	tests := []struct {
+		desc         string
+		inputLink    string
+		inputTotal   string
+		inputPage    int
+		inputPerPage int
+
+		expectedLastPage   int
+		expectedNextPage   int
+		expectedTotalCount int
+		expectedTruncated  bool
+		expectedErrString  string
+	}{
+		{
+			desc:         "success",
+			inputLink:    `<https://qiita.com/api/v2/tags?page=1&per_page=20>; rel="first", <https://qiita.com/api/v2/tags?page=3&per_page=20>; rel="next", <https://qiita.com/api/v2/tags?page=5&per_page=20>; rel="last"`,
+			inputTotal:   "100",
+			inputPage:    2,
+			inputPerPage: 20,
+
+			expectedLastPage:   5,
+			expectedNextPage:   3,
+			expectedTotalCount: 100,
+		},
+		{
+			desc:         "success-truncated",
+			inputLink:    `<https://qiita.com/api/v2/items?page=1&per_page=20>; rel="first", <https://qiita.com/api/v2/items?page=101&per_page=20>; rel="next", <https://qiita.com/api/v2/items?page=200&per_page=20>; rel="last"`,
+			inputTotal:   "4000",
+			inputPage:    100,
+			inputPerPage: 20,
+
+			expectedLastPage:   100,
+			expectedNextPage:   0,
+			expectedTotalCount: 4000,
+			expectedTruncated:  true,
+		},
+		{
+			desc:         "success-no_last_link",
+			inputLink:    `<https://qiita.com/api/v2/tags?page=1&per_page=20>; rel="first", <https://qiita.com/api/v2/tags?page=3&per_page=20>; rel="next"`,
+			inputTotal:   "100",
+			inputPage:    2,
+			inputPerPage: 20,
+
+			expectedLastPage:   3,
+			expectedNextPage:   3,
+			expectedTotalCount: 100,
+		},
+		{
+			desc:         "success-no_link",
+			inputPage:    1,
+			inputPerPage: 20,
+
+			expectedLastPage: 1,
+		},
+		{
+			desc:         "failure-invalid_total_count",
+			inputLink:    `<https://qiita.com/api/v2/tags?page=1&per_page=20>; rel="last"`,
+			inputTotal:   "many",
+			inputPage:    1,
+			inputPerPage: 20,
+
+			expectedErrString: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			header := http.Header{}
+			if tt.inputLink != "" {
+				header.Set("link", tt.inputLink)
+			}
+			if tt.inputTotal != "" {
+				header.Set("total-count", tt.inputTotal)
+			}
+
+			info, err := extractPaginationInfo(header, tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.inputPage, info.Page)
+				assert.Equal(t, tt.inputPerPage, info.PerPage)
+				assert.Equal(t, tt.expectedLastPage, info.LastPage)
+				assert.Equal(t, tt.expectedNextPage, info.NextPage)
+				assert.Equal(t, tt.expectedTotalCount, info.TotalCount)
+				assert.Equal(t, tt.expectedTruncated, info.Truncated)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
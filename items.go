@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"path"
@@ -30,6 +31,9 @@ type Item struct {
 
 	User     *User      `json:"user"`
 	ItemTags []*ItemTag `json:"tags"`
+
+	// Group is the group the item is posted into. It is nil unless the item is on Qiita Team.
+	Group *Group `json:"group"`
 }
 
 // ItemTag represents a tag for a qiita item.
@@ -45,7 +49,10 @@ type ItemsResponse struct {
 	Page       int
 	FirstPage  int
 	LastPage   int
+	NextPage   int
 	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
 }
 
 func newItemsResponse(items []*Item, header http.Header, page, perPage int) (*ItemsResponse, error) {
@@ -60,7 +67,10 @@ func newItemsResponse(items []*Item, header http.Header, page, perPage int) (*It
 		Page:       paginationInfo.Page,
 		FirstPage:  paginationInfo.FirstPage,
 		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
 		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
 	}, nil
 }
 
@@ -71,6 +81,107 @@ type ItemDraft struct {
 	ItemTags []*ItemTag `json:"tags"`
 	Private  bool       `json:"private"`
 	Tweet    bool       `json:"tweet"`
+
+	// GroupURLName is the url name of the group to post the item into. It is available only on Qiita Team.
+	GroupURLName string `json:"group_url_name,omitempty"`
+}
+
+// ItemPatch represents the fields of an item to be updated. Fields which are nil are not updated.
+// ItemTags should not be empty if it is not nil, because an item needs at least one tag.
+type ItemPatch struct {
+	Title    *string    `json:"title,omitempty"`
+	Body     *string    `json:"body,omitempty"`
+	ItemTags []*ItemTag `json:"tags,omitempty"`
+	Private  *bool      `json:"private,omitempty"`
+}
+
+func (p *ItemPatch) isEmpty() bool {
+	return p.Title == nil && p.Body == nil && p.ItemTags == nil && p.Private == nil
+}
+
+func (p *ItemPatch) validate() error {
+	if p == nil || p.isEmpty() {
+		return errors.New("patch should have at least one field to update")
+	}
+	if p.ItemTags != nil && len(p.ItemTags) == 0 {
+		return errors.New("tags of patch should not be empty. an item needs at least one tag")
+	}
+	return nil
+}
+
+// String returns a pointer to s, which helps to set fields of ItemPatch.
+func String(s string) *string {
+	return &s
+}
+
+// Bool returns a pointer to b, which helps to set fields of ItemPatch.
+func Bool(b bool) *bool {
+	return &b
+}
+
+func copyItemTags(itemTags []*ItemTag) []*ItemTag {
+	if itemTags == nil {
+		return nil
+	}
+
+	copied := make([]*ItemTag, 0, len(itemTags))
+	for _, tag := range itemTags {
+		if tag == nil {
+			copied = append(copied, nil)
+			continue
+		}
+		t := *tag
+		if tag.Versions != nil {
+			t.Versions = append(make([]string, 0, len(tag.Versions)), tag.Versions...)
+		}
+		copied = append(copied, &t)
+	}
+	return copied
+}
+
+func itemTagsEqual(a, b []*ItemTag) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] == nil || b[i] == nil {
+			if a[i] != b[i] {
+				return false
+			}
+			continue
+		}
+		if a[i].Name != b[i].Name || len(a[i].Versions) != len(b[i].Versions) {
+			return false
+		}
+		for j := range a[i].Versions {
+			if a[i].Versions[j] != b[i].Versions[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// diffItem returns the patch which changes the updatable fields of original into the ones of modified.
+func diffItem(original, modified *Item) *ItemPatch {
+	patch := &ItemPatch{}
+	if modified.Title != original.Title {
+		patch.Title = String(modified.Title)
+	}
+	if modified.Body != original.Body {
+		patch.Body = String(modified.Body)
+	}
+	if !itemTagsEqual(modified.ItemTags, original.ItemTags) {
+		// cleared tags are kept as an empty slice so that validate rejects them instead of leaving them unchanged.
+		patch.ItemTags = modified.ItemTags
+		if patch.ItemTags == nil {
+			patch.ItemTags = []*ItemTag{}
+		}
+	}
+	if modified.Private != original.Private {
+		patch.Private = Bool(modified.Private)
+	}
+	return patch
 }
 
 // GetItem fetches the item having provided itemID.
@@ -86,17 +197,15 @@ func (c *Client) GetItem(ctx context.Context, itemID string) (*Item, error) {
 	var item Item
 	code, _, err := c.doRequest(req, &item)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return &item, nil
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return &item, nil
 }
 
 // GetItems fetches all the items posted on qiita.
@@ -104,6 +213,11 @@ func (c *Client) GetItem(ctx context.Context, itemID string) (*Item, error) {
 // GET /api/v2/items
 // document: http://qiita.com/api/v2/docs#get-apiv2items
 func (c *Client) GetItems(ctx context.Context, page, perPage int) (*ItemsResponse, error) {
+	return c.getItems(ctx, "", page, perPage)
+}
+
+// getItems fetches the items matching provided search query. All the items are fetched if query is empty.
+func (c *Client) getItems(ctx context.Context, query string, page, perPage int) (*ItemsResponse, error) {
 	if err := validatePaginationLimit(page, perPage); err != nil {
 		return nil, err
 	}
@@ -112,23 +226,21 @@ func (c *Client) GetItems(ctx context.Context, page, perPage int) (*ItemsRespons
 		"page":     strconv.Itoa(page),
 		"per_page": strconv.Itoa(perPage),
 	}
+	if query != "" {
+		queries["query"] = query
+	}
 	req, err := c.newRequest(ctx, http.MethodGet, "items", queries, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var items []*Item
-	code, header, err := c.doRequest(req, &items)
+	_, header, err := c.doRequest(req, &items)
 	if err != nil {
 		return nil, err
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newItemsResponse(items, header, page, perPage)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newItemsResponse(items, header, page, perPage)
 }
 
 // GetItemComments fetches the comments posted on provided itemID.
@@ -144,17 +256,15 @@ func (c *Client) GetItemComments(ctx context.Context, itemID string) ([]*Comment
 	var comments []*Comment
 	code, _, err := c.doRequest(req, &comments)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return comments, nil
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return comments, nil
 }
 
 // GetItemStockers fetches the users who stocked the item having provided itemID.
@@ -177,18 +287,48 @@ func (c *Client) GetItemStockers(ctx context.Context, itemID string, page, perPa
 
 	var users []*User
 	code, header, err := c.doRequest(req, &users)
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newUsersResponse(users, header, page, perPage)
+}
+
+// GetItemLikes fetches the likes on the item having provided itemID.
+//
+// GET /api/v2/items/:item_id/likes
+// document: http://qiita.com/api/v2/docs#get-apiv2itemsitem_idlikes
+func (c *Client) GetItemLikes(ctx context.Context, itemID string, page, perPage int) (*LikesResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("items", itemID, "likes"), queries, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newUsersResponse(users, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
+	var likes []*Like
+	code, header, err := c.doRequest(req, &likes)
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return nil, err
+		}
 	}
+
+	return newLikesResponse(likes, header, page, perPage)
 }
 
 // CreateItem publishes the item.
@@ -197,7 +337,19 @@ func (c *Client) GetItemStockers(ctx context.Context, itemID string, page, perPa
 // POST /api/v2/items
 // document: http://qiita.com/api/v2/docs#post-apiv2items
 func (c *Client) CreateItem(ctx context.Context, title, body string, itemTags []*ItemTag, private, tweet bool) (*Item, error) {
-	itemDraft := &ItemDraft{Title: title, Body: body, ItemTags: itemTags, Private: private, Tweet: tweet}
+	return c.createItem(ctx, &ItemDraft{Title: title, Body: body, ItemTags: itemTags, Private: private, Tweet: tweet})
+}
+
+// CreateGroupItem publishes the item into the group having provided groupURLName.
+// This method requires authentication, and is available only on Qiita Team.
+//
+// POST /api/v2/items
+// document: http://qiita.com/api/v2/docs#post-apiv2items
+func (c *Client) CreateGroupItem(ctx context.Context, groupURLName, title, body string, itemTags []*ItemTag, private, tweet bool) (*Item, error) {
+	return c.createItem(ctx, &ItemDraft{Title: title, Body: body, ItemTags: itemTags, Private: private, Tweet: tweet, GroupURLName: groupURLName})
+}
+
+func (c *Client) createItem(ctx context.Context, itemDraft *ItemDraft) (*Item, error) {
 	bodyBytes, err := json.Marshal(itemDraft)
 	if err != nil {
 		return nil, err
@@ -214,29 +366,86 @@ func (c *Client) CreateItem(ctx context.Context, title, body string, itemTags []
 	var item Item
 	code, _, err := c.doRequest(req, &item)
 	if err != nil {
-		return nil, err
-	}
-
-	switch code {
-	case http.StatusCreated:
-		return &item, nil
-	case http.StatusUnauthorized:
-		return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusForbidden:
-		return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid (status = %d)", code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			if itemDraft.GroupURLName != "" {
+				return nil, fmt.Errorf("group with url name '%s' not found: %w", itemDraft.GroupURLName, err)
+			}
+			return nil, err
+		default:
+			return nil, err
+		}
+	}
+
+	return &item, nil
 }
 
 // UpdateItem update the item having provided itemID.
+// All the fields are overwritten. Use UpdateItemFields to update only some of them.
 // This method requires authentication.
 //
 // PATCH /api/v2/items/:item_id
 // document: http://qiita.com/api/v2/docs#patch-apiv2itemsitem_id
 func (c *Client) UpdateItem(ctx context.Context, itemID string, title, body string, itemTags []*ItemTag, private, tweet bool) (*Item, error) {
-	itemDraft := &ItemDraft{Title: title, Body: body, ItemTags: itemTags, Private: private, Tweet: tweet}
-	bodyBytes, err := json.Marshal(itemDraft)
+	return c.updateItem(ctx, itemID, &ItemDraft{Title: title, Body: body, ItemTags: itemTags, Private: private, Tweet: tweet})
+}
+
+// UpdateItemFields updates only the fields set in patch of the item having provided itemID.
+// This method requires authentication.
+//
+// PATCH /api/v2/items/:item_id
+// document: http://qiita.com/api/v2/docs#patch-apiv2itemsitem_id
+func (c *Client) UpdateItemFields(ctx context.Context, itemID string, patch *ItemPatch) (*Item, error) {
+	if err := patch.validate(); err != nil {
+		return nil, err
+	}
+
+	return c.updateItem(ctx, itemID, patch)
+}
+
+// ModifyItem fetches the item having provided itemID, lets modify change it and updates the fields changed by modify.
+// Only Title, Body, ItemTags and Private are updated, and the item is not updated if none of them is changed.
+// If the item has been updated by someone else since it was fetched, which is detected by UpdatedAt,
+// ModifyItem returns an error matching ErrConflict without updating it.
+// This method requires authentication.
+func (c *Client) ModifyItem(ctx context.Context, itemID string, modify func(item *Item) error) (*Item, error) {
+	item, err := c.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	modified := *item
+	modified.ItemTags = copyItemTags(item.ItemTags)
+	if err := modify(&modified); err != nil {
+		return nil, err
+	}
+
+	patch := diffItem(item, &modified)
+	if patch.isEmpty() {
+		return item, nil
+	}
+	if err := patch.validate(); err != nil {
+		return nil, err
+	}
+
+	// qiita API has no conditional update, so the item is fetched again right before updating it.
+	latest, err := c.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if !latest.UpdatedAt.Equal(item.UpdatedAt) {
+		return nil, fmt.Errorf("item with id '%s' has been updated at %s since it was fetched: %w", itemID, latest.UpdatedAt.Format(time.RFC3339), ErrConflict)
+	}
+
+	return c.updateItem(ctx, itemID, patch)
+}
+
+func (c *Client) updateItem(ctx context.Context, itemID string, fields interface{}) (*Item, error) {
+	bodyBytes, err := json.Marshal(fields)
 	if err != nil {
 		return nil, err
 	}
@@ -252,21 +461,19 @@ func (c *Client) UpdateItem(ctx context.Context, itemID string, title, body stri
 	var item Item
 	code, _, err := c.doRequest(req, &item)
 	if err != nil {
-		return nil, err
-	}
-
-	switch code {
-	case http.StatusOK:
-		return &item, nil
-	case http.StatusUnauthorized:
-		return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusForbidden:
-		return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid (status = %d)", code)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &item, nil
 }
 
 // DeleteItem deletes the item.
@@ -282,21 +489,19 @@ func (c *Client) DeleteItem(ctx context.Context, itemID string) error {
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return err
-	}
-
-	switch code {
-	case http.StatusNoContent:
-		return nil
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusForbidden:
-		return fmt.Errorf("forbidden. some required field values may be empty or invalid (status = %d)", code)
-	case http.StatusNotFound:
-		return fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	default:
-		return fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
 }
 
 // CreateItemComment post comments on the item having provided itemID.
@@ -322,19 +527,17 @@ func (c *Client) CreateItemComment(ctx context.Context, itemID string, body stri
 	var comment Comment
 	code, _, err := c.doRequest(req, &comment)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusCreated:
-		return &comment, nil
-	case http.StatusUnauthorized:
-		return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusForbidden:
-		return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid (status = %d)", code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return &comment, nil
 }
 
 // IsStockedItem returns true if the authenticated user has stocked the item having provided itemID.
@@ -350,18 +553,17 @@ func (c *Client) IsStockedItem(ctx context.Context, itemID string) (bool, error)
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return false, err
-	}
-	switch code {
-	case http.StatusNoContent:
-		return true, nil
-	case http.StatusNotFound:
-		return false, nil
-	case http.StatusUnauthorized:
-		return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	default:
-		return false, fmt.Errorf("unknown error (status = %d)", code)
+		switch code {
+		case http.StatusNotFound:
+			return false, nil
+		case http.StatusUnauthorized:
+			return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return false, err
+		}
 	}
+
+	return true, nil
 }
 
 // StockItem add the item having provided itemID to the authenticated user's stock list.
@@ -377,20 +579,19 @@ func (c *Client) StockItem(ctx context.Context, itemID string) error {
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return err
-	}
-	switch code {
-	case http.StatusNoContent:
-		return nil
-	case http.StatusForbidden:
-		return fmt.Errorf("forbidden. you may already have stocked item with id '%s' (status = %d)", itemID, code)
-	case http.StatusNotFound:
-		return fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	default:
-		return fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have stocked item with id '%s': %w", itemID, err)
+		case http.StatusNotFound:
+			return fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return err
+		}
+	}
+
+	return nil
 }
 
 // UnstockItem remove the item having provided itemID from the authenticated user's stock list.
@@ -405,17 +606,96 @@ func (c *Client) UnstockItem(ctx context.Context, itemID string) error {
 	}
 
 	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsLikedItem returns true if the authenticated user has liked the item having provided itemID.
+// This method requires authentication.
+//
+// GET /api/v2/items/:item_id/like
+// document: http://qiita.com/api/v2/docs#get-apiv2itemsitem_idlike
+func (c *Client) IsLikedItem(ctx context.Context, itemID string) (bool, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("items", itemID, "like"), nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return false, nil
+		case http.StatusUnauthorized:
+			return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// LikeItem likes the item having provided itemID.
+// This method requires authentication.
+//
+// PUT /api/v2/items/:item_id/like
+// document: http://qiita.com/api/v2/docs#put-apiv2itemsitem_idlike
+func (c *Client) LikeItem(ctx context.Context, itemID string) error {
+	req, err := c.newRequest(ctx, http.MethodPut, path.Join("items", itemID, "like"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have liked item with id '%s': %w", itemID, err)
+		case http.StatusNotFound:
+			return fmt.Errorf("item with id '%s' not found: %w", itemID, err)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnlikeItem removes the like on the item having provided itemID.
+// This method requires authentication.
+//
+// DELETE /api/v2/items/:item_id/like
+// document: http://qiita.com/api/v2/docs#delete-apiv2itemsitem_idlike
+func (c *Client) UnlikeItem(ctx context.Context, itemID string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("items", itemID, "like"), nil, nil, nil)
 	if err != nil {
 		return err
 	}
-	switch code {
-	case http.StatusNoContent:
-		return nil
-	case http.StatusNotFound:
-		return fmt.Errorf("item with id '%s' not found (status = %d)", itemID, code)
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	default:
-		return fmt.Errorf("unknown error (status = %d)", code)
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return fmt.Errorf("item with id '%s' not found or not liked: %w", itemID, err)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return err
+		}
 	}
+
+	return nil
 }
@@ -2,9 +2,13 @@ package qiita
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strings"
 	"testing"
@@ -30,7 +34,9 @@ func TestClient_GetItems(t *testing.T) {
 		expectedPerPage     int
 		expectedFirstPage   int
 		expectedLastPage    int
+		expectedNextPage    int
 		expectedTotalCount  int
+		expectedTruncated   bool
 		expectedItemsLen    int
 	}{
 		{
@@ -48,7 +54,9 @@ func TestClient_GetItems(t *testing.T) {
 			expectedPerPage:     2,
 			expectedFirstPage:   1,
 			expectedLastPage:    100,
+			expectedNextPage:    4,
 			expectedTotalCount:  392649,
+			expectedTruncated:   true,
 			expectedItemsLen:    2,
 		},
 		{
@@ -80,7 +88,9 @@ func TestClient_GetItems(t *testing.T) {
 				assert.Equal(t, tt.expectedPerPage, itemsResp.PerPage)
 				assert.Equal(t, tt.expectedFirstPage, itemsResp.FirstPage)
 				assert.Equal(t, tt.expectedLastPage, itemsResp.LastPage)
+				assert.Equal(t, tt.expectedNextPage, itemsResp.NextPage)
 				assert.Equal(t, tt.expectedTotalCount, itemsResp.TotalCount)
+				assert.Equal(t, tt.expectedTruncated, itemsResp.Truncated)
 				assert.Equal(t, tt.expectedItemsLen, len(itemsResp.Items))
 			} else {
 				if !assert.NotNil(t, err) {
@@ -449,6 +459,108 @@ func TestClient_CreateItem(t *testing.T) {
 	}
 }
 
+func TestClient_CreateGroupItem(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "CreateGroupItem")
+
+	tests := []struct {
+		desc              string
+		inputGroupURLName string
+		inputTitle        string
+		inputBody         string
+		inputItemTags     []*ItemTag
+		inputPrivate      bool
+		inputTweet        bool
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod       string
+		expectedRequestPath  string
+		expectedRawQuery     string
+		expectedErrString    string
+		expectedTitle        string
+		expectedGroupName    string
+		expectedGroupURLName string
+	}{
+		{
+			desc:              "success",
+			inputGroupURLName: "backend",
+			inputTitle:        "test title",
+			inputBody:         "# test body",
+			inputItemTags:     []*ItemTag{{Name: "test_tag", Versions: []string{"0.0.1"}}},
+			inputPrivate:      false,
+			inputTweet:        false,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:       http.MethodPost,
+			expectedRequestPath:  "/items",
+			expectedTitle:        "test title",
+			expectedGroupName:    "バックエンド",
+			expectedGroupURLName: "backend",
+		},
+		{
+			desc:              "failure-no_token",
+			inputGroupURLName: "backend",
+			inputTitle:        "test title",
+			inputBody:         "# test body",
+			inputItemTags:     []*ItemTag{{Name: "test_tag", Versions: []string{"0.0.1"}}},
+			inputPrivate:      false,
+			inputTweet:        false,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:              "failure-not_exist",
+			inputGroupURLName: "nonexistent",
+			inputTitle:        "test title",
+			inputBody:         "# test body",
+			inputItemTags:     []*ItemTag{{Name: "test_tag", Versions: []string{"0.0.1"}}},
+			inputPrivate:      false,
+			inputTweet:        false,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items",
+			expectedErrString:   "group with url name 'nonexistent' not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			item, err := cli.CreateGroupItem(context.Background(), tt.inputGroupURLName, tt.inputTitle, tt.inputBody, tt.inputItemTags, tt.inputPrivate, tt.inputTweet)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedTitle, item.Title)
+				if !assert.NotNil(t, item.Group) {
+					t.FailNow()
+				}
+				assert.Equal(t, tt.expectedGroupName, item.Group.Name)
+				assert.Equal(t, tt.expectedGroupURLName, item.Group.URLName)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
 func TestClient_UpdateItem(t *testing.T) {
 	mockFilesBaseDir := path.Join("testdata", "responses", "items", "UpdateItem")
 
@@ -583,6 +695,290 @@ func TestClient_UpdateItem(t *testing.T) {
 	}
 }
 
+func TestItemPatch_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		desc  string
+		patch *ItemPatch
+
+		expected string
+	}{
+		{
+			desc:  "title",
+			patch: &ItemPatch{Title: String("updated title")},
+
+			expected: `{"title":"updated title"}`,
+		},
+		{
+			desc:  "zero_values",
+			patch: &ItemPatch{Body: String(""), Private: Bool(false)},
+
+			expected: `{"body":"","private":false}`,
+		},
+		{
+			desc:  "all",
+			patch: &ItemPatch{Title: String("title"), Body: String("body"), ItemTags: []*ItemTag{{Name: "go", Versions: []string{}}}, Private: Bool(true)},
+
+			expected: `{"title":"title","body":"body","tags":[{"name":"go","versions":[]}],"private":true}`,
+		},
+		{
+			desc:  "empty",
+			patch: &ItemPatch{},
+
+			expected: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			b, err := json.Marshal(tt.patch)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.expected, string(b))
+		})
+	}
+}
+
+func TestClient_UpdateItemFields(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "UpdateItem")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+		inputPatch  *ItemPatch
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedTitle       string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  &ItemPatch{Title: String("updated title")},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/items/115aecdce865a6d31a6f",
+			expectedTitle:       "updated title",
+		},
+		{
+			desc:        "failure-empty_patch",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  &ItemPatch{},
+
+			expectedErrString: "at least one field",
+		},
+		{
+			desc:        "failure-empty_tags",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  &ItemPatch{Title: String("updated title"), ItemTags: []*ItemTag{}},
+
+			expectedErrString: "should not be empty",
+		},
+		{
+			desc:        "failure-nil_patch",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  nil,
+
+			expectedErrString: "at least one field",
+		},
+		{
+			desc:        "failure-empty_title",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  &ItemPatch{Title: String("")},
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/items/115aecdce865a6d31a6f",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "115aecdce865a6d31a6f",
+			inputPatch:  &ItemPatch{Title: String("updated title")},
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/items/115aecdce865a6d31a6f",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+			inputPatch:  &ItemPatch{Title: String("updated title")},
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/items/nonexistent",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			item, err := cli.UpdateItemFields(context.Background(), tt.inputItemID, tt.inputPatch)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedTitle, item.Title)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_ModifyItem(t *testing.T) {
+	const original = `{"id":"115aecdce865a6d31a6f","title":"title","body":"body","private":false,"tags":[{"name":"go","versions":[]}],"updated_at":"2019-04-01T12:00:00+09:00"}`
+	const concurrentlyUpdated = `{"id":"115aecdce865a6d31a6f","title":"title","body":"edited by someone","private":false,"tags":[{"name":"go","versions":[]}],"updated_at":"2019-04-01T12:30:00+09:00"}`
+
+	tests := []struct {
+		desc        string
+		inputModify func(item *Item) error
+		secondGet   string
+
+		expectedRequests  []string
+		expectedPatch     string
+		expectedErrString string
+		expectedConflict  bool
+		expectedTitle     string
+	}{
+		{
+			desc: "success",
+			inputModify: func(item *Item) error {
+				item.Title = "updated title"
+				item.ItemTags[0].Versions = append(item.ItemTags[0].Versions, "1.12")
+				return nil
+			},
+			secondGet: original,
+
+			expectedRequests: []string{
+				"GET /items/115aecdce865a6d31a6f",
+				"GET /items/115aecdce865a6d31a6f",
+				"PATCH /items/115aecdce865a6d31a6f",
+			},
+			expectedPatch: `{"title":"updated title","tags":[{"name":"go","versions":["1.12"]}]}`,
+			expectedTitle: "updated title",
+		},
+		{
+			desc: "success-no_change",
+			inputModify: func(item *Item) error {
+				item.LikesCount = 100
+				return nil
+			},
+
+			expectedRequests: []string{
+				"GET /items/115aecdce865a6d31a6f",
+			},
+			expectedTitle: "title",
+		},
+		{
+			desc: "failure-modify_error",
+			inputModify: func(item *Item) error {
+				return fmt.Errorf("refused to modify")
+			},
+
+			expectedRequests: []string{
+				"GET /items/115aecdce865a6d31a6f",
+			},
+			expectedErrString: "refused to modify",
+		},
+		{
+			desc: "failure-empty_tags",
+			inputModify: func(item *Item) error {
+				item.ItemTags = nil
+				return nil
+			},
+
+			expectedRequests: []string{
+				"GET /items/115aecdce865a6d31a6f",
+			},
+			expectedErrString: "should not be empty",
+		},
+		{
+			desc: "failure-conflict",
+			inputModify: func(item *Item) error {
+				item.Private = true
+				return nil
+			},
+			secondGet: concurrentlyUpdated,
+
+			expectedRequests: []string{
+				"GET /items/115aecdce865a6d31a6f",
+				"GET /items/115aecdce865a6d31a6f",
+			},
+			expectedErrString: "has been updated at 2019-04-01T12:30:00+09:00",
+			expectedConflict:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var requests []string
+			var patch string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				w.Header().Set("content-type", "application/json")
+				switch req.Method {
+				case http.MethodGet:
+					if len(requests) == 1 {
+						_, _ = w.Write([]byte(original))
+					} else {
+						_, _ = w.Write([]byte(tt.secondGet))
+					}
+				case http.MethodPatch:
+					b, _ := ioutil.ReadAll(req.Body)
+					patch = string(b)
+					_, _ = w.Write([]byte(`{"id":"115aecdce865a6d31a6f","title":"updated title"}`))
+				}
+			}))
+			defer server.Close()
+			cli := newPagedClient(t, server)
+
+			item, err := cli.ModifyItem(context.Background(), "115aecdce865a6d31a6f", tt.inputModify)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedTitle, item.Title)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+				assert.Equal(t, tt.expectedConflict, errors.Is(err, ErrConflict))
+			}
+			assert.Equal(t, tt.expectedRequests, requests)
+			assert.Equal(t, tt.expectedPatch, patch)
+		})
+	}
+}
+
 func TestClient_DeleteItem(t *testing.T) {
 	mockFilesBaseDir := path.Join("testdata", "responses", "items", "DeleteItem")
 
@@ -817,3 +1213,354 @@ func TestClient_UnstockItem(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetItemLikes(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItemLikes")
+
+	tests := []struct {
+		desc         string
+		inputItemID  string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedPage        int
+		expectedPerPage     int
+		expectedFirstPage   int
+		expectedLastPage    int
+		expectedTotalCount  int
+		expectedLikesLen    int
+		expectedFirstUserID string
+	}{
+		{
+			desc:         "success",
+			inputItemID:  "b4ca1773580317e7112e",
+			inputPage:    3,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/likes",
+			expectedRawQuery:    "page=3&per_page=2",
+			expectedPage:        3,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    86,
+			expectedTotalCount:  171,
+			expectedLikesLen:    2,
+			expectedFirstUserID: "simanja",
+		},
+		{
+			desc:         "failure-out_of_range",
+			inputItemID:  "b4ca1773580317e7112e",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/likes",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-not_found",
+			inputItemID:  "nonexistent",
+			inputPage:    3,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/nonexistent/likes",
+			expectedRawQuery:    "page=3&per_page=2",
+			expectedErrString:   "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			likesResp, err := cli.GetItemLikes(context.Background(), tt.inputItemID, tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedPage, likesResp.Page)
+				assert.Equal(t, tt.expectedPerPage, likesResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, likesResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, likesResp.LastPage)
+				assert.Equal(t, tt.expectedTotalCount, likesResp.TotalCount)
+				assert.Equal(t, tt.expectedLikesLen, len(likesResp.Likes))
+				assert.Equal(t, tt.expectedFirstUserID, likesResp.Likes[0].User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+func TestClient_LikeItem(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "LikeItem")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedIsStocked   bool
+		expectedErrString   string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+		},
+		{
+			desc:        "failure-already_liked",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "already_liked-header",
+			mockResponseBodyFile:   "already_liked-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/items/nonexistent/like",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.LikeItem(context.Background(), tt.inputItemID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+
+		})
+	}
+}
+func TestClient_UnlikeItem(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "UnlikeItem")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedIsStocked   bool
+		expectedErrString   string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+		},
+		{
+			desc:        "failure-not_liked",
+			inputItemID: "b4ca1773580317e7112e",
+
+			mockResponseHeaderFile: "not_liked-header",
+			mockResponseBodyFile:   "not_liked-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/like",
+			expectedErrString:   "not liked",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/nonexistent/like",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.UnlikeItem(context.Background(), tt.inputItemID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+
+		})
+	}
+}
+
+func TestClient_IsLikedItem(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "IsLikedItem")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedIsLiked     bool
+		expectedErrString   string
+	}{
+		{
+			desc:        "success-liked",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "liked-header",
+			mockResponseBodyFile:   "liked-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+			expectedIsLiked:     true,
+		},
+		{
+			desc:        "success-not_liked",
+			inputItemID: "b4ca1773580317e7112e",
+
+			mockResponseHeaderFile: "not_liked-header",
+			mockResponseBodyFile:   "not_liked-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/like",
+			expectedIsLiked:     false,
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/nonexistent/like",
+			expectedIsLiked:     false,
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "68f6ee99a35a15ed8074",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/68f6ee99a35a15ed8074/like",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			isLiked, err := cli.IsLikedItem(context.Background(), tt.inputItemID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedIsLiked, isLiked)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package qiita
+
+import (
+	"context"
+)
+
+// pager walks through paginated responses following their rel="next" links.
+type pager struct {
+	perPage  int
+	maxItems int
+
+	nextPage int
+	index    int
+	size     int
+	count    int
+	err      error
+
+	// fetch requests the page and returns the number of elements in it and the next page number.
+	// The next page number is 0 if the page is the last one.
+	fetch func(ctx context.Context, page, perPage int) (int, int, error)
+}
+
+func newPager(perPage int, fetch func(ctx context.Context, page, perPage int) (int, int, error)) pager {
+	return pager{
+		perPage:  perPage,
+		nextPage: PageMin,
+		fetch:    fetch,
+	}
+}
+
+func (p *pager) next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if p.maxItems > 0 && p.count >= p.maxItems {
+		return false
+	}
+
+	p.index++
+	for p.index >= p.size {
+		if p.nextPage == 0 {
+			return false
+		}
+
+		size, nextPage, err := p.fetch(ctx, p.nextPage, p.perPage)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.index, p.size, p.nextPage = 0, size, nextPage
+	}
+
+	p.count++
+	return true
+}
+
+// ItemIterator iterates over items across pages.
+//
+//	it := cli.IterateItems(100)
+//	for it.Next(ctx) {
+//		item := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type ItemIterator struct {
+	pager
+	items []*Item
+}
+
+func newItemIterator(perPage int, get func(ctx context.Context, page, perPage int) (*ItemsResponse, error)) *ItemIterator {
+	it := &ItemIterator{}
+	it.pager = newPager(perPage, func(ctx context.Context, page, perPage int) (int, int, error) {
+		resp, err := get(ctx, page, perPage)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.items = resp.Items
+		return len(resp.Items), resp.NextPage, nil
+	})
+	return it
+}
+
+// WithMaxItems makes the iterator stop after n items. No limit is applied if n is 0.
+func (it *ItemIterator) WithMaxItems(n int) *ItemIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator to the next item, fetching the next page if needed.
+// It returns false when no item is left or an error occurs.
+func (it *ItemIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current item.
+func (it *ItemIterator) Value() *Item {
+	return it.items[it.index]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+// UserIterator iterates over users across pages.
+type UserIterator struct {
+	pager
+	users []*User
+}
+
+func newUserIterator(perPage int, get func(ctx context.Context, page, perPage int) (*UsersResponse, error)) *UserIterator {
+	it := &UserIterator{}
+	it.pager = newPager(perPage, func(ctx context.Context, page, perPage int) (int, int, error) {
+		resp, err := get(ctx, page, perPage)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.users = resp.Users
+		return len(resp.Users), resp.NextPage, nil
+	})
+	return it
+}
+
+// WithMaxItems makes the iterator stop after n users. No limit is applied if n is 0.
+func (it *UserIterator) WithMaxItems(n int) *UserIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator to the next user, fetching the next page if needed.
+// It returns false when no user is left or an error occurs.
+func (it *UserIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current user.
+func (it *UserIterator) Value() *User {
+	return it.users[it.index]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// TagIterator iterates over tags across pages.
+type TagIterator struct {
+	pager
+	tags []*Tag
+}
+
+func newTagIterator(perPage int, get func(ctx context.Context, page, perPage int) (*TagsResponse, error)) *TagIterator {
+	it := &TagIterator{}
+	it.pager = newPager(perPage, func(ctx context.Context, page, perPage int) (int, int, error) {
+		resp, err := get(ctx, page, perPage)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.tags = resp.Tags
+		return len(resp.Tags), resp.NextPage, nil
+	})
+	return it
+}
+
+// WithMaxItems makes the iterator stop after n tags. No limit is applied if n is 0.
+func (it *TagIterator) WithMaxItems(n int) *TagIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator to the next tag, fetching the next page if needed.
+// It returns false when no tag is left or an error occurs.
+func (it *TagIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current tag.
+func (it *TagIterator) Value() *Tag {
+	return it.tags[it.index]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *TagIterator) Err() error {
+	return it.err
+}
+
+// LikeIterator iterates over likes across pages.
+type LikeIterator struct {
+	pager
+	likes []*Like
+}
+
+func newLikeIterator(perPage int, get func(ctx context.Context, page, perPage int) (*LikesResponse, error)) *LikeIterator {
+	it := &LikeIterator{}
+	it.pager = newPager(perPage, func(ctx context.Context, page, perPage int) (int, int, error) {
+		resp, err := get(ctx, page, perPage)
+		if err != nil {
+			return 0, 0, err
+		}
+		it.likes = resp.Likes
+		return len(resp.Likes), resp.NextPage, nil
+	})
+	return it
+}
+
+// WithMaxItems makes the iterator stop after n likes. No limit is applied if n is 0.
+func (it *LikeIterator) WithMaxItems(n int) *LikeIterator {
+	it.maxItems = n
+	return it
+}
+
+// Next advances the iterator to the next like, fetching the next page if needed.
+// It returns false when no like is left or an error occurs.
+func (it *LikeIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current like.
+func (it *LikeIterator) Value() *Like {
+	return it.likes[it.index]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *LikeIterator) Err() error {
+	return it.err
+}
+
+// IterateItems returns an iterator over all the items posted on qiita.
+// perPage is the number of items fetched by one request.
+func (c *Client) IterateItems(perPage int) *ItemIterator {
+	return newItemIterator(perPage, c.GetItems)
+}
+
+// IterateItemLikes returns an iterator over the likes on the item having provided itemID.
+func (c *Client) IterateItemLikes(itemID string, perPage int) *LikeIterator {
+	return newLikeIterator(perPage, func(ctx context.Context, page, perPage int) (*LikesResponse, error) {
+		return c.GetItemLikes(ctx, itemID, page, perPage)
+	})
+}
+
+// IterateItemStockers returns an iterator over the users who stocked the item having provided itemID.
+func (c *Client) IterateItemStockers(itemID string, perPage int) *UserIterator {
+	return newUserIterator(perPage, func(ctx context.Context, page, perPage int) (*UsersResponse, error) {
+		return c.GetItemStockers(ctx, itemID, page, perPage)
+	})
+}
+
+// IterateUsers returns an iterator over all the users.
+func (c *Client) IterateUsers(perPage int) *UserIterator {
+	return newUserIterator(perPage, c.GetUsers)
+}
+
+// IterateUserFollowees returns an iterator over the followees of the user having provided userID.
+func (c *Client) IterateUserFollowees(userID string, perPage int) *UserIterator {
+	return newUserIterator(perPage, func(ctx context.Context, page, perPage int) (*UsersResponse, error) {
+		return c.GetUserFollowees(ctx, userID, page, perPage)
+	})
+}
+
+// IterateUserFollowers returns an iterator over the followers of the user having provided userID.
+func (c *Client) IterateUserFollowers(userID string, perPage int) *UserIterator {
+	return newUserIterator(perPage, func(ctx context.Context, page, perPage int) (*UsersResponse, error) {
+		return c.GetUserFollowers(ctx, userID, page, perPage)
+	})
+}
+
+// IterateUserItems returns an iterator over the items created by the user having provided userID.
+func (c *Client) IterateUserItems(userID string, perPage int) *ItemIterator {
+	return newItemIterator(perPage, func(ctx context.Context, page, perPage int) (*ItemsResponse, error) {
+		return c.GetUserItems(ctx, userID, page, perPage)
+	})
+}
+
+// IterateUserStocks returns an iterator over the items stocked by the user having provided userID.
+func (c *Client) IterateUserStocks(userID string, perPage int) *ItemIterator {
+	return newItemIterator(perPage, func(ctx context.Context, page, perPage int) (*ItemsResponse, error) {
+		return c.GetUserStocks(ctx, userID, page, perPage)
+	})
+}
+
+// IterateUserFollowingTags returns an iterator over the tags followed by the user having provided userID.
+func (c *Client) IterateUserFollowingTags(userID string, perPage int) *TagIterator {
+	return newTagIterator(perPage, func(ctx context.Context, page, perPage int) (*TagsResponse, error) {
+		return c.GetUserFollowingTags(ctx, userID, page, perPage)
+	})
+}
+
+// IterateTags returns an iterator over all the tags in the order specified by sort.
+// The arguments are in the same order as GetTags.
+func (c *Client) IterateTags(perPage int, sort TagSort) *TagIterator {
+	return newTagIterator(perPage, func(ctx context.Context, page, perPage int) (*TagsResponse, error) {
+		return c.GetTags(ctx, page, perPage, sort)
+	})
+}
+
+// IterateTagItems returns an iterator over the items which the tag having provided tagID is attached.
+func (c *Client) IterateTagItems(tagID string, perPage int) *ItemIterator {
+	return newItemIterator(perPage, func(ctx context.Context, page, perPage int) (*ItemsResponse, error) {
+		return c.GetTagItems(ctx, tagID, page, perPage)
+	})
+}
+
+// IterateAuthenticatedUserItems returns an iterator over the items created by the authenticated user.
+// This method requires authentication.
+func (c *Client) IterateAuthenticatedUserItems(perPage int) *ItemIterator {
+	return newItemIterator(perPage, c.GetAuthenticatedUserItems)
+}
@@ -0,0 +1,204 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newPagedServer returns a server which serves total elements rendered by render
+// over pages with link and total-count headers as qiita API does.
+// A request for failPage is responded with 500 if failPage is positive.
+func newPagedServer(t *testing.T, expectedRequestPath string, total, failPage int, render func(i int) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !assert.Equal(t, expectedRequestPath, req.URL.Path) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(req.URL.Query().Get("per_page"))
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		lastPage := (total + perPage - 1) / perPage
+		if lastPage == 0 {
+			lastPage = 1
+		}
+		pageURL := func(p int) string {
+			return fmt.Sprintf("<https://qiita.com/api/v2%s?page=%d&per_page=%d>", expectedRequestPath, p, perPage)
+		}
+		links := []string{pageURL(1) + `; rel="first"`}
+		if page > 1 {
+			links = append(links, pageURL(page-1)+`; rel="prev"`)
+		}
+		if page < lastPage {
+			links = append(links, pageURL(page+1)+`; rel="next"`)
+		}
+		links = append(links, pageURL(lastPage)+`; rel="last"`)
+
+		var elements []string
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			elements = append(elements, render(i))
+		}
+
+		w.Header().Set("link", strings.Join(links, ", "))
+		w.Header().Set("total-count", strconv.Itoa(total))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("[" + strings.Join(elements, ",") + "]"))
+	}))
+}
+
+func newPagedClient(t *testing.T, server *httptest.Server) *Client {
+	serverURL, err := url.Parse(server.URL)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	return &Client{
+		URL:        serverURL,
+		HTTPClient: server.Client(),
+		Logger:     NewStdLogger(log.New(ioutil.Discard, "", 0)),
+	}
+}
+
+func TestItemIterator(t *testing.T) {
+	tests := []struct {
+		desc     string
+		total    int
+		perPage  int
+		maxItems int
+		failPage int
+
+		expectedIDs []string
+		expectedErr bool
+	}{
+		{
+			desc:    "success-multiple_pages",
+			total:   5,
+			perPage: 2,
+
+			expectedIDs: []string{"item0", "item1", "item2", "item3", "item4"},
+		},
+		{
+			desc:    "success-empty",
+			total:   0,
+			perPage: 2,
+		},
+		{
+			desc:     "success-max_items",
+			total:    5,
+			perPage:  2,
+			maxItems: 3,
+
+			expectedIDs: []string{"item0", "item1", "item2"},
+		},
+		{
+			desc:     "failure-second_page",
+			total:    5,
+			perPage:  2,
+			failPage: 2,
+
+			expectedIDs: []string{"item0", "item1"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := newPagedServer(t, "/users/muiscript/items", tt.total, tt.failPage, func(i int) string {
+				return fmt.Sprintf(`{"id":"item%d"}`, i)
+			})
+			defer server.Close()
+			cli := newPagedClient(t, server)
+
+			it := cli.IterateUserItems("muiscript", tt.perPage).WithMaxItems(tt.maxItems)
+			var ids []string
+			for it.Next(context.Background()) {
+				ids = append(ids, it.Value().ID)
+			}
+
+			assert.Equal(t, tt.expectedIDs, ids)
+			if tt.expectedErr {
+				var apiErr *APIError
+				assert.True(t, errors.As(it.Err(), &apiErr))
+			} else {
+				assert.Nil(t, it.Err())
+			}
+			assert.False(t, it.Next(context.Background()))
+		})
+	}
+}
+
+func TestUserIterator(t *testing.T) {
+	server := newPagedServer(t, "/items/b4ca1773580317e7112e/stockers", 3, 0, func(i int) string {
+		return fmt.Sprintf(`{"id":"user%d"}`, i)
+	})
+	defer server.Close()
+	cli := newPagedClient(t, server)
+
+	it := cli.IterateItemStockers("b4ca1773580317e7112e", 2)
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"user0", "user1", "user2"}, ids)
+}
+
+func TestTagIterator(t *testing.T) {
+	server := newPagedServer(t, "/users/muiscript/following_tags", 3, 0, func(i int) string {
+		return fmt.Sprintf(`{"id":"tag%d"}`, i)
+	})
+	defer server.Close()
+	cli := newPagedClient(t, server)
+
+	it := cli.IterateUserFollowingTags("muiscript", 1)
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"tag0", "tag1", "tag2"}, ids)
+}
+
+func TestTagIterator_NoLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	cli := newPagedClient(t, server)
+
+	it := cli.IterateTags(20, TagSortCount)
+	assert.False(t, it.Next(context.Background()))
+	assert.Nil(t, it.Err())
+}
+
+func TestLikeIterator(t *testing.T) {
+	server := newPagedServer(t, "/items/b4ca1773580317e7112e/likes", 3, 0, func(i int) string {
+		return fmt.Sprintf(`{"user":{"id":"user%d"}}`, i)
+	})
+	defer server.Close()
+	cli := newPagedClient(t, server)
+
+	it := cli.IterateItemLikes("b4ca1773580317e7112e", 2)
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().User.ID)
+	}
+
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"user0", "user1", "user2"}, ids)
+}
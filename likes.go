@@ -0,0 +1,44 @@
+package qiita
+
+import (
+	"net/http"
+	"time"
+)
+
+// Like represents a like on qiita item.
+type Like struct {
+	CreatedAt time.Time `json:"created_at"`
+	User      *User     `json:"user"`
+}
+
+// LikesResponse represents a response from qiita API which includes multiple likes.
+type LikesResponse struct {
+	Likes      []*Like
+	PerPage    int
+	Page       int
+	FirstPage  int
+	LastPage   int
+	NextPage   int
+	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
+}
+
+func newLikesResponse(likes []*Like, header http.Header, page, perPage int) (*LikesResponse, error) {
+	paginationInfo, err := extractPaginationInfo(header, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LikesResponse{
+		Likes:      likes,
+		PerPage:    paginationInfo.PerPage,
+		Page:       paginationInfo.Page,
+		FirstPage:  paginationInfo.FirstPage,
+		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
+		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
+	}, nil
+}
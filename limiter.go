@@ -0,0 +1,138 @@
+package qiita
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// AuthenticatedRateLimit is the number of requests allowed per hour for an access token.
+	AuthenticatedRateLimit = 1000
+	// AnonymousRateLimit is the number of requests allowed per hour for an IP address without access token.
+	AnonymousRateLimit = 60
+
+	rateLimitWindow = time.Hour
+)
+
+// RateLimiter is a token bucket which throttles requests of a Client shared across goroutines.
+//
+// The budget is AuthenticatedRateLimit or AnonymousRateLimit per hour depending on whether the first request
+// carries an access token, and the bucket is refilled continuously at that rate.
+// It is seeded from rate-limit, rate-remaining and rate-reset headers of every response,
+// and blocks all requests until rate-reset once qiita API reports no remaining request.
+type RateLimiter struct {
+	// OnWait is called after a request waited for the limiter, with the time it waited.
+	OnWait func(req *http.Request, wait time.Duration)
+
+	mu           sync.Mutex
+	window       time.Duration
+	capacity     float64
+	tokens       float64
+	last         time.Time
+	reset        time.Time
+	blockedUntil time.Time
+}
+
+// NewRateLimiter returns a RateLimiter whose budget is determined by the first request.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{window: rateLimitWindow}
+}
+
+// Wait blocks until the request is allowed to be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, req *http.Request) error {
+	var waited time.Duration
+	for {
+		wait := l.reserve(req, time.Now())
+		if wait <= 0 {
+			break
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+		waited += wait
+	}
+
+	if waited > 0 && l.OnWait != nil {
+		l.OnWait(req, waited)
+	}
+	return nil
+}
+
+// reserve takes a token if available. Otherwise it returns the time until a token becomes available.
+func (l *RateLimiter) reserve(req *http.Request, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.capacity == 0 {
+		l.capacity = AnonymousRateLimit
+		if req.Header.Get("Authorization") != "" {
+			l.capacity = AuthenticatedRateLimit
+		}
+		l.tokens = l.capacity
+		l.last = now
+	}
+	l.refill(now)
+
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate())
+}
+
+// refill adds tokens for the time elapsed since the last refill. A reported window reset fills the bucket.
+func (l *RateLimiter) refill(now time.Time) {
+	if !l.blockedUntil.IsZero() && !now.Before(l.blockedUntil) {
+		l.blockedUntil = time.Time{}
+		l.tokens = l.capacity
+	}
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += float64(elapsed) * l.rate()
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+	}
+	l.last = now
+}
+
+// rate returns the number of tokens added per nanosecond.
+func (l *RateLimiter) rate() float64 {
+	window := l.window
+	if window <= 0 {
+		window = rateLimitWindow
+	}
+	return l.capacity / float64(window)
+}
+
+// observe seeds the bucket from the rate limit state reported by qiita API.
+// Within the same window, the remaining count only lowers the tokens
+// because responses of requests sent concurrently may arrive out of order.
+func (l *RateLimiter) observe(rl *RateLimit, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rl.Limit > 0 {
+		l.capacity = float64(rl.Limit)
+	}
+	l.refill(now)
+
+	remaining := float64(rl.Remaining)
+	if rl.Reset.After(l.reset) {
+		l.reset = rl.Reset
+		l.tokens = remaining
+	} else if remaining < l.tokens {
+		l.tokens = remaining
+	}
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	if rl.Remaining <= 0 && now.Before(rl.Reset) {
+		l.blockedUntil = rl.Reset
+	}
+}
@@ -0,0 +1,188 @@
+package qiita
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Budget(t *testing.T) {
+	tests := []struct {
+		desc          string
+		authorization string
+
+		expectedCapacity float64
+	}{
+		{
+			desc:          "authenticated",
+			authorization: "Bearer access_token",
+
+			expectedCapacity: AuthenticatedRateLimit,
+		},
+		{
+			desc: "anonymous",
+
+			expectedCapacity: AnonymousRateLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			limiter := NewRateLimiter()
+			if !assert.Nil(t, limiter.Wait(context.Background(), req)) {
+				t.FailNow()
+			}
+			assert.Equal(t, tt.expectedCapacity, limiter.capacity)
+			assert.True(t, limiter.tokens < tt.expectedCapacity)
+		})
+	}
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	tests := []struct {
+		desc       string
+		rateLimit  *RateLimit
+		window     time.Duration
+		ctxTimeout time.Duration
+
+		expectedMinWait time.Duration
+		expectedErr     error
+	}{
+		{
+			desc:      "no_wait-remaining",
+			rateLimit: &RateLimit{Limit: 1000, Remaining: 1, Reset: time.Now().Add(time.Hour)},
+			window:    time.Hour,
+		},
+		{
+			desc:      "wait-reset",
+			rateLimit: &RateLimit{Limit: 1000, Remaining: 0, Reset: time.Now().Add(100 * time.Millisecond)},
+			window:    time.Hour,
+
+			expectedMinWait: 50 * time.Millisecond,
+		},
+		{
+			desc:      "wait-refill",
+			rateLimit: &RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(-time.Second)},
+			window:    time.Second,
+
+			expectedMinWait: 50 * time.Millisecond,
+		},
+		{
+			desc:       "failure-context_deadline",
+			rateLimit:  &RateLimit{Limit: 1000, Remaining: 0, Reset: time.Now().Add(time.Hour)},
+			window:     time.Hour,
+			ctxTimeout: 10 * time.Millisecond,
+
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var waited time.Duration
+			limiter := &RateLimiter{
+				OnWait: func(req *http.Request, wait time.Duration) { waited = wait },
+				window: tt.window,
+			}
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			req.Header.Set("Authorization", "Bearer access_token")
+
+			// the first request initializes the budget and the response seeds the bucket
+			if !assert.Nil(t, limiter.Wait(context.Background(), req)) {
+				t.FailNow()
+			}
+			limiter.observe(tt.rateLimit, time.Now())
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			err := limiter.Wait(ctx, req)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.True(t, time.Since(start) >= tt.expectedMinWait)
+			if tt.expectedErr == nil {
+				assert.True(t, waited >= tt.expectedMinWait, waited)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	const limit = 5
+
+	reset := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		remaining := limit - n
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		w.Header().Set("rate-limit", strconv.Itoa(limit))
+		w.Header().Set("rate-remaining", strconv.Itoa(int(remaining)))
+		w.Header().Set("rate-reset", reset)
+		if n > limit {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Rate limit exceeded","type":"rate_limit_exceeded"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"b4ca1773580317e7112e"}`))
+	}))
+	defer server.Close()
+
+	var waits int32
+	limiter := NewRateLimiter()
+	limiter.OnWait = func(req *http.Request, wait time.Duration) { atomic.AddInt32(&waits, 1) }
+	cli, err := NewClient("access_token", WithBaseURL(server.URL), WithRateLimiter(limiter))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	// the limiter is seeded by the first response and lets only the remaining requests through
+	if _, err := cli.GetItem(context.Background(), "b4ca1773580317e7112e"); !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var succeeded, canceled int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := cli.GetItem(ctx, "b4ca1773580317e7112e")
+			switch {
+			case err == nil:
+				atomic.AddInt32(&succeeded, 1)
+			case ctx.Err() != nil:
+				atomic.AddInt32(&canceled, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(limit), atomic.LoadInt32(&calls))
+	assert.Equal(t, int32(limit-1), succeeded)
+	assert.Equal(t, int32(10-limit+1), canceled)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&waits))
+}
@@ -0,0 +1,118 @@
+package qiita
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+)
+
+// Logger is a leveled structured logger.
+// args are alternating keys and values as log/slog takes, so *slog.Logger satisfies Logger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// NewStdLogger returns a Logger which writes to a *log.Logger in the form of
+// "LEVEL msg key1=value1 key2=value2". Logs are discarded if logger is nil.
+func NewStdLogger(logger *log.Logger) Logger {
+	if logger == nil {
+		return nopLogger{}
+	}
+	return &stdLogger{logger: logger}
+}
+
+type stdLogger struct {
+	logger *log.Logger
+}
+
+func (l *stdLogger) Debug(msg string, args ...interface{}) { l.print("DEBUG", msg, args) }
+func (l *stdLogger) Info(msg string, args ...interface{})  { l.print("INFO", msg, args) }
+func (l *stdLogger) Warn(msg string, args ...interface{})  { l.print("WARN", msg, args) }
+func (l *stdLogger) Error(msg string, args ...interface{}) { l.print("ERROR", msg, args) }
+
+func (l *stdLogger) print(level, msg string, args []interface{}) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 < len(args) {
+			fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+		} else {
+			fmt.Fprintf(&b, " !BADKEY=%v", args[i])
+		}
+	}
+	l.logger.Println(b.String())
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
+
+func (c *Client) logger() Logger {
+	if c.Logger == nil {
+		return nopLogger{}
+	}
+	return c.Logger
+}
+
+const redacted = "[REDACTED]"
+
+// sensitiveQueryKeys are query parameters whose values are never logged.
+var sensitiveQueryKeys = []string{"access_token", "token", "client_secret", "code"}
+
+// redactQuery returns the raw query of u with credentials replaced.
+func redactQuery(u *url.URL) string {
+	if u.RawQuery == "" {
+		return ""
+	}
+
+	q := u.Query()
+	for _, k := range sensitiveQueryKeys {
+		if _, ok := q[k]; ok {
+			q.Set(k, redacted)
+		}
+	}
+	return q.Encode()
+}
+
+// redactError returns err with credentials redacted from the URL of the *url.Error in it,
+// which http.Client returns with the full request URL on transport failures.
+func redactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	redactedURL := redacted
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		u.Path = redactPath(u)
+		u.RawPath = ""
+		u.RawQuery = redactQuery(u)
+		redactedURL = u.String()
+	}
+	if err == error(urlErr) {
+		return &url.Error{Op: urlErr.Op, URL: redactedURL, Err: urlErr.Err}
+	}
+	return errors.New(strings.Replace(err.Error(), urlErr.URL, redactedURL, -1))
+}
+
+// redactPath returns the path of u with access tokens in it replaced,
+// such as the one in /api/v2/access_tokens/:access_token.
+func redactPath(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	for i := 1; i < len(segments); i++ {
+		if segments[i-1] == "access_tokens" && segments[i] != "" {
+			segments[i] = redacted
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,283 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type logEntry struct {
+	level string
+	msg   string
+	attrs map[string]interface{}
+}
+
+// recordingLogger is a Logger which keeps all the entries.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Debug(msg string, args ...interface{}) { l.record("DEBUG", msg, args) }
+func (l *recordingLogger) Info(msg string, args ...interface{})  { l.record("INFO", msg, args) }
+func (l *recordingLogger) Warn(msg string, args ...interface{})  { l.record("WARN", msg, args) }
+func (l *recordingLogger) Error(msg string, args ...interface{}) { l.record("ERROR", msg, args) }
+
+func (l *recordingLogger) record(level, msg string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	attrs := make(map[string]interface{})
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[fmt.Sprint(args[i])] = args[i+1]
+	}
+	l.entries = append(l.entries, logEntry{level: level, msg: msg, attrs: attrs})
+}
+
+func TestClient_Logger(t *testing.T) {
+	tests := []struct {
+		desc             string
+		mockFilesBaseDir string
+		call             func(cli *Client)
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod        string
+		expectedRequestPath   string
+		expectedRawQuery      string
+		expectedLevel         string
+		expectedStatus        int
+		expectedRateRemaining string
+		expectedRequestID     string
+	}{
+		{
+			desc:             "success",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItems"),
+			call: func(cli *Client) {
+				_, _ = cli.GetItems(context.Background(), 3, 2)
+			},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:        http.MethodGet,
+			expectedRequestPath:   "/items",
+			expectedRawQuery:      "page=3&per_page=2",
+			expectedLevel:         "DEBUG",
+			expectedStatus:        http.StatusOK,
+			expectedRateRemaining: "57",
+			expectedRequestID:     "1d07cdaa-d383-4d7b-983f-d7290f25b651",
+		},
+		{
+			desc:             "failure-not_exist",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItem"),
+			call: func(cli *Client) {
+				_, _ = cli.GetItem(context.Background(), "nonexistent")
+			},
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:        http.MethodGet,
+			expectedRequestPath:   "/items/nonexistent",
+			expectedLevel:         "WARN",
+			expectedStatus:        http.StatusNotFound,
+			expectedRateRemaining: "54",
+			expectedRequestID:     "38dd249d-e222-4895-b8af-7bde309aa638",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, tt.mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			logger := &recordingLogger{}
+			cli.Logger = logger
+
+			tt.call(cli)
+
+			if !assert.Equal(t, 1, len(logger.entries)) {
+				t.FailNow()
+			}
+			entry := logger.entries[0]
+			assert.Equal(t, tt.expectedLevel, entry.level)
+			assert.Equal(t, tt.expectedMethod, entry.attrs["method"])
+			assert.Equal(t, tt.expectedRequestPath, entry.attrs["path"])
+			assert.Equal(t, tt.expectedRawQuery, entry.attrs["query"])
+			assert.Equal(t, tt.expectedStatus, entry.attrs["status"])
+			assert.Equal(t, tt.expectedRateRemaining, entry.attrs["rate_remaining"])
+			assert.Equal(t, tt.expectedRequestID, entry.attrs["request_id"])
+			assert.Contains(t, entry.attrs, "duration")
+			assert.Contains(t, entry.attrs, "bytes")
+		})
+	}
+}
+
+func TestClient_Logger_Redacted(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"Unauthorized","type":"unauthorized"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	cli, err := NewClient(secret, WithBaseURL(server.URL), WithLogger(NewStdLogger(log.New(&buf, "", 0))))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	cli.Use(LoggingMiddleware(NewStdLogger(log.New(&buf, "", 0))))
+
+	req, err := cli.newRequest(context.Background(), http.MethodDelete, path.Join("access_tokens", secret), map[string]string{"access_token": secret}, nil, nil)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	_, _, err = cli.doRequest(req, &struct{}{})
+	if !assert.NotNil(t, err) {
+		t.FailNow()
+	}
+
+	assert.False(t, strings.Contains(buf.String(), secret), buf.String())
+	assert.False(t, strings.Contains(err.Error(), secret), err.Error())
+	assert.True(t, strings.Contains(buf.String(), "WARN qiita request method=DELETE path=/access_tokens/[REDACTED]"), buf.String())
+}
+
+func TestClient_Logger_Redacted_TransportError(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	// the server is closed before the request so that the transport fails with the request URL in its error.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	server.Close()
+
+	var buf bytes.Buffer
+	cli, err := NewClient(secret, WithBaseURL(server.URL), WithLogger(NewStdLogger(log.New(&buf, "", 0))))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	cli.Use(LoggingMiddleware(NewStdLogger(log.New(&buf, "", 0))))
+
+	req, err := cli.newRequest(context.Background(), http.MethodDelete, path.Join("access_tokens", secret), map[string]string{"access_token": secret}, nil, nil)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	_, _, err = cli.doRequest(req, &struct{}{})
+	if !assert.NotNil(t, err) {
+		t.FailNow()
+	}
+
+	assert.False(t, strings.Contains(buf.String(), secret), buf.String())
+	assert.True(t, strings.Contains(buf.String(), "WARN http request failed method=DELETE path=/access_tokens/[REDACTED]"), buf.String())
+	assert.True(t, strings.Contains(buf.String(), "ERROR qiita request failed method=DELETE path=/access_tokens/[REDACTED]"), buf.String())
+	assert.True(t, strings.Contains(buf.String(), "/access_tokens/%5BREDACTED%5D?access_token=%5BREDACTED%5D"), buf.String())
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewStdLogger(log.New(&buf, "", 0))
+
+	logger.Debug("debug message", "key", "value", "count", 1)
+	logger.Info("info message")
+	logger.Warn("warn message", "dangling")
+	logger.Error("error message", "err", fmt.Errorf("failed"))
+
+	expected := "DEBUG debug message key=value count=1\n" +
+		"INFO info message\n" +
+		"WARN warn message !BADKEY=dangling\n" +
+		"ERROR error message err=failed\n"
+	assert.Equal(t, expected, buf.String())
+
+	assert.Equal(t, nopLogger{}, NewStdLogger(nil))
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		desc  string
+		input string
+
+		expectedPath  string
+		expectedQuery string
+	}{
+		{
+			desc:  "no_credentials",
+			input: "https://qiita.com/api/v2/items?page=1&per_page=20",
+
+			expectedPath:  "/api/v2/items",
+			expectedQuery: "page=1&per_page=20",
+		},
+		{
+			desc:  "access_token_in_query",
+			input: "https://qiita.com/api/v2/items?access_token=secret&page=1",
+
+			expectedPath:  "/api/v2/items",
+			expectedQuery: "access_token=%5BREDACTED%5D&page=1",
+		},
+		{
+			desc:  "access_token_in_path",
+			input: "https://qiita.com/api/v2/access_tokens/secret",
+
+			expectedPath: "/api/v2/access_tokens/[REDACTED]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			u, err := url.Parse(tt.input)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.expectedPath, redactPath(u))
+			assert.Equal(t, tt.expectedQuery, redactQuery(u))
+		})
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	urlErr := &url.Error{Op: "Delete", URL: "https://qiita.com/api/v2/access_tokens/secret?access_token=secret", Err: context.Canceled}
+
+	tests := []struct {
+		desc  string
+		input error
+
+		expected string
+	}{
+		{
+			desc:  "url_error",
+			input: urlErr,
+
+			expected: `Delete "https://qiita.com/api/v2/access_tokens/%5BREDACTED%5D?access_token=%5BREDACTED%5D": context canceled`,
+		},
+		{
+			desc:  "wrapped_url_error",
+			input: fmt.Errorf("middleware: %w", urlErr),
+
+			expected: `middleware: Delete "https://qiita.com/api/v2/access_tokens/%5BREDACTED%5D?access_token=%5BREDACTED%5D": context canceled`,
+		},
+		{
+			desc:  "other_error",
+			input: fmt.Errorf("failed"),
+
+			expected: "failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redactError(tt.input).Error())
+		})
+	}
+	assert.True(t, errors.Is(redactError(urlErr), context.Canceled))
+}
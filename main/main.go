@@ -1,3 +1,6 @@
+//go:build ignore
+// +build ignore
+
 package main
 
 // this main function works as integration test of this package
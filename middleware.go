@@ -0,0 +1,72 @@
+package qiita
+
+import (
+	"net/http"
+	"time"
+)
+
+// Doer sends an HTTP request and returns its response. *http.Client implements Doer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use an ordinary function as a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer to run extra logic around every request sent by the client.
+type Middleware func(next Doer) Doer
+
+// Use adds middlewares which wrap every request sent by the client, including each retry attempt.
+// Middlewares added earlier run outer. Use should not be called concurrently with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// doer returns the HTTPClient wrapped by the middlewares.
+// The Cache is applied innermost so that middlewares see the responses served from it.
+func (c *Client) doer() Doer {
+	var d Doer = c.HTTPClient
+	if c.Cache != nil {
+		d = c.cachingDoer(d)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		d = c.middlewares[i](d)
+	}
+	return d
+}
+
+// LoggingMiddleware returns a Middleware which logs the method, path, status and duration of every request
+// at debug level, and failed requests at warn level. Credentials in the request are redacted.
+func LoggingMiddleware(logger Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.Do(req)
+			if err != nil {
+				logger.Warn("http request failed", requestLogArgs(req, start, "error", redactError(err))...)
+				return resp, err
+			}
+			logger.Debug("http request", requestLogArgs(req, start, "status", resp.StatusCode)...)
+			return resp, nil
+		})
+	}
+}
+
+// HeaderMiddleware returns a Middleware which sets provided headers to every request.
+// The headers overwrite the ones set by the client.
+func HeaderMiddleware(headers map[string]string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			return next.Do(req)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestClient_Use(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItem")
+	cli, teardown := setup(t, mockFilesBaseDir, "success-header", "success-body", http.MethodGet, "/items/b4ca1773580317e7112e", "")
+	defer teardown()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next.Do(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			})
+		}
+	}
+	cli.Use(record("outer"), record("inner"))
+
+	_, err := cli.GetItem(context.Background(), "b4ca1773580317e7112e")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, calls)
+}
+
+func TestClient_Use_ShortCircuit(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItem")
+	cli, teardown := setup(t, mockFilesBaseDir, "success-header", "success-body", http.MethodGet, "/items/b4ca1773580317e7112e", "")
+	defer teardown()
+
+	injected := errors.New("injected failure")
+	cli.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, injected
+		})
+	})
+
+	_, err := cli.GetItem(context.Background(), "b4ca1773580317e7112e")
+	assert.True(t, errors.Is(err, injected))
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "trace-id", req.Header.Get("X-Trace-Id"))
+		assert.Equal(t, "custom-agent", req.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"b4ca1773580317e7112e"}`))
+	}))
+	defer server.Close()
+
+	cli, err := NewClient("", WithBaseURL(server.URL), WithMiddleware(HeaderMiddleware(map[string]string{
+		"X-Trace-Id": "trace-id",
+		"User-Agent": "custom-agent",
+	})))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	item, err := cli.GetItem(context.Background(), "b4ca1773580317e7112e")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "b4ca1773580317e7112e", item.ID)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItem")
+	cli, teardown := setup(t, mockFilesBaseDir, "not_exist-header", "not_exist-body", http.MethodGet, "/items/nonexistent", "")
+	defer teardown()
+
+	var buf bytes.Buffer
+	cli.Use(LoggingMiddleware(NewStdLogger(log.New(&buf, "", 0))))
+
+	_, _ = cli.GetItem(context.Background(), "nonexistent")
+
+	assert.True(t, strings.HasPrefix(buf.String(), "DEBUG http request method=GET path=/items/nonexistent query= duration="), buf.String())
+	assert.True(t, strings.HasSuffix(buf.String(), " status=404\n"), buf.String())
+}
@@ -0,0 +1,159 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrStateMismatch is matched by the error returned from CallbackServer.Wait when the context is done
+// after a redirect whose state is different from the one in the authorization URL has been rejected.
+var ErrStateMismatch = errors.New("oauth: state mismatch")
+
+// stateMismatchError matches both ErrStateMismatch and the error of the context it was returned on.
+type stateMismatchError struct {
+	ctxErr error
+}
+
+func (e *stateMismatchError) Error() string {
+	return fmt.Sprintf("%s: no redirect with the expected state before %s", ErrStateMismatch, e.ctxErr)
+}
+
+func (e *stateMismatchError) Is(target error) bool {
+	return target == ErrStateMismatch
+}
+
+func (e *stateMismatchError) Unwrap() error {
+	return e.ctxErr
+}
+
+// CallbackServer is an HTTP server on a loopback address which receives the code redirected from qiita.
+type CallbackServer struct {
+	url      *url.URL
+	state    string
+	listener net.Listener
+	server   *http.Server
+
+	once   sync.Once
+	result chan callbackResult
+
+	// mismatched is set to 1 when a redirect with a different state is rejected.
+	mismatched int32
+}
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// NewCallbackServer starts serving the callback on redirectURL, which should be a loopback http URL.
+// Port 0 in redirectURL chooses a free port, which can be known by URL.
+// Only the redirect having provided state is accepted.
+func NewCallbackServer(redirectURL, state string) (*CallbackServer, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" {
+		return nil, fmt.Errorf("redirect URL should be http. got '%s'", redirectURL)
+	}
+	switch u.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+	default:
+		return nil, fmt.Errorf("redirect URL should be a loopback address. got '%s'", redirectURL)
+	}
+	if state == "" {
+		return nil, errors.New("state should not be empty")
+	}
+
+	listener, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return nil, err
+	}
+	u.Host = net.JoinHostPort(u.Hostname(), fmt.Sprint(listener.Addr().(*net.TCPAddr).Port))
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	s := &CallbackServer{
+		url:      u,
+		state:    state,
+		listener: listener,
+		result:   make(chan callbackResult, 1),
+	}
+	s.server = &http.Server{Handler: http.HandlerFunc(s.handle)}
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	return s, nil
+}
+
+// URL returns the URL the server is serving the callback on.
+func (s *CallbackServer) URL() string {
+	return s.url.String()
+}
+
+// Wait blocks until the code is redirected or ctx is done.
+// If a redirect with a different state has been rejected by then, the error matches ErrStateMismatch as well as ctx.Err().
+func (s *CallbackServer) Wait(ctx context.Context) (string, error) {
+	select {
+	case r := <-s.result:
+		return r.code, r.err
+	case <-ctx.Done():
+		if atomic.LoadInt32(&s.mismatched) == 1 {
+			return "", &stateMismatchError{ctxErr: ctx.Err()}
+		}
+		return "", ctx.Err()
+	}
+}
+
+// Close stops the server.
+func (s *CallbackServer) Close() error {
+	return s.server.Close()
+}
+
+func (s *CallbackServer) handle(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != s.url.Path {
+		http.NotFound(w, req)
+		return
+	}
+
+	q := req.URL.Query()
+	if q.Get("state") != s.state {
+		// a redirect without the right state may be forged, so it is rejected and the server keeps waiting.
+		atomic.StoreInt32(&s.mismatched, 1)
+		http.Error(w, ErrStateMismatch.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var r callbackResult
+	switch {
+	case q.Get("error") != "":
+		r.err = fmt.Errorf("authorization failed: %s", q.Get("error"))
+	case q.Get("code") == "":
+		r.err = errors.New("authorization failed: no code is redirected")
+	default:
+		r.code = q.Get("code")
+	}
+
+	delivered := false
+	s.once.Do(func() {
+		s.result <- r
+		delivered = true
+	})
+	if !delivered {
+		http.Error(w, "authorization has already been completed", http.StatusConflict)
+		return
+	}
+	if r.err != nil {
+		http.Error(w, r.err.Error(), http.StatusBadRequest)
+		return
+	}
+	_, _ = fmt.Fprintln(w, "authorization completed. you can close this window.")
+}
@@ -0,0 +1,194 @@
+// Package oauth implements the OAuth 2.0 authorization code flow of qiita API v2,
+// which issues access tokens for qiita.Client on behalf of users.
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/muiscript/qiita"
+)
+
+// Scope is a permission granted to an access token.
+type Scope = qiita.Scope
+
+// Scopes of qiita API v2.
+const (
+	ScopeReadQiita      = qiita.ScopeReadQiita
+	ScopeWriteQiita     = qiita.ScopeWriteQiita
+	ScopeReadQiitaTeam  = qiita.ScopeReadQiitaTeam
+	ScopeWriteQiitaTeam = qiita.ScopeWriteQiitaTeam
+)
+
+// Config is the configuration of an OAuth application registered on qiita.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	Scopes       []Scope
+
+	// RedirectURL is the redirect URL registered on qiita. It is used by Authorize and NewCallbackServer,
+	// and should be a loopback address such as http://127.0.0.1:8080/callback.
+	RedirectURL string
+
+	// BaseURL is the base URL of qiita API. qiita.BaseURL is used if it is empty.
+	BaseURL string
+	// HTTPClient is used to exchange codes for tokens. http.DefaultClient is used if it is nil.
+	HTTPClient *http.Client
+}
+
+// NewState returns a random string to be used as the state parameter, which protects the flow from CSRF.
+func NewState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// AuthCodeURL returns the URL of the page on which the user allows the application to access qiita.
+// state should be checked when the user is redirected back with the code.
+//
+// GET /api/v2/oauth/authorize
+// document: http://qiita.com/api/v2/docs#get-apiv2oauthauthorize
+func (c *Config) AuthCodeURL(state string) (string, error) {
+	u, err := c.endpoint("oauth", "authorize")
+	if err != nil {
+		return "", err
+	}
+
+	scopes := make([]string, 0, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	q := url.Values{}
+	q.Set("client_id", c.ClientID)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange issues an access token for the code which qiita passed to the redirect URL.
+//
+// POST /api/v2/access_tokens
+// document: http://qiita.com/api/v2/docs#post-apiv2access_tokens
+func (c *Config) Exchange(ctx context.Context, code string) (*qiita.AccessToken, error) {
+	if code == "" {
+		return nil, errors.New("code should not be empty")
+	}
+
+	u, err := c.endpoint("access_tokens")
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := json.Marshal(map[string]string{
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		apiErr := &qiita.APIError{}
+		_ = json.Unmarshal(respBytes, apiErr)
+		apiErr.StatusCode = resp.StatusCode
+		apiErr.RequestID = resp.Header.Get("x-request-id")
+		apiErr.Method = req.Method
+		apiErr.Path = req.URL.Path
+
+		switch resp.StatusCode {
+		case http.StatusBadRequest, http.StatusForbidden:
+			return nil, fmt.Errorf("code may be invalid or expired, or client id/secret may be wrong: %w", apiErr)
+		default:
+			return nil, apiErr
+		}
+	}
+
+	var token qiita.AccessToken
+	if err := json.Unmarshal(respBytes, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Authorize runs the whole authorization code flow on a loopback address.
+// It serves the callback on RedirectURL, passes the authorization URL to open, which should show it to the user
+// for example by opening a browser, and exchanges the code redirected back for an access token.
+// It blocks until the code is redirected or ctx is done.
+func (c *Config) Authorize(ctx context.Context, open func(authURL string) error) (*qiita.AccessToken, error) {
+	state, err := NewState()
+	if err != nil {
+		return nil, err
+	}
+	authURL, err := c.AuthCodeURL(state)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := NewCallbackServer(c.RedirectURL, state)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = server.Close()
+	}()
+
+	if err := open(authURL); err != nil {
+		return nil, err
+	}
+
+	code, err := server.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.Exchange(ctx, code)
+}
+
+func (c *Config) endpoint(elems ...string) (*url.URL, error) {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = qiita.BaseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(append([]string{u.Path}, elems...)...)
+	return u, nil
+}
@@ -0,0 +1,344 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/muiscript/qiita"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	tests := []struct {
+		desc        string
+		inputConfig *Config
+		inputState  string
+
+		expectedURL string
+	}{
+		{
+			desc: "success",
+			inputConfig: &Config{
+				ClientID: "client",
+				Scopes:   []Scope{ScopeReadQiita, ScopeWriteQiita},
+			},
+			inputState: "state",
+
+			expectedURL: "https://qiita.com/api/v2/oauth/authorize?client_id=client&scope=read_qiita+write_qiita&state=state",
+		},
+		{
+			desc: "success-base_url",
+			inputConfig: &Config{
+				ClientID: "client",
+				Scopes:   []Scope{ScopeReadQiitaTeam},
+				BaseURL:  "https://increments.qiita.com/api/v2",
+			},
+			inputState: "state",
+
+			expectedURL: "https://increments.qiita.com/api/v2/oauth/authorize?client_id=client&scope=read_qiita_team&state=state",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			authURL, err := tt.inputConfig.AuthCodeURL(tt.inputState)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.expectedURL, authURL)
+		})
+	}
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	tests := []struct {
+		desc      string
+		inputCode string
+
+		mockResponseStatus int
+		mockResponseBody   string
+
+		expectedErrString string
+		expectedToken     string
+		expectedScopes    []Scope
+	}{
+		{
+			desc:      "success",
+			inputCode: "code",
+
+			mockResponseStatus: http.StatusCreated,
+			mockResponseBody:   `{"client_id":"client","scopes":["read_qiita","write_qiita"],"token":"token"}`,
+
+			expectedToken:  "token",
+			expectedScopes: []Scope{ScopeReadQiita, ScopeWriteQiita},
+		},
+		{
+			desc:      "failure-invalid_code",
+			inputCode: "invalid",
+
+			mockResponseStatus: http.StatusForbidden,
+			mockResponseBody:   `{"message":"Forbidden","type":"forbidden"}`,
+
+			expectedErrString: "code may be invalid",
+		},
+		{
+			desc:      "failure-empty_code",
+			inputCode: "",
+
+			expectedErrString: "code should not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/api/v2/access_tokens", req.URL.Path)
+
+				var body map[string]string
+				assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"client_id": "client", "client_secret": "secret", "code": tt.inputCode}, body)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.mockResponseStatus)
+				_, _ = w.Write([]byte(tt.mockResponseBody))
+			}))
+			defer server.Close()
+
+			config := &Config{ClientID: "client", ClientSecret: "secret", BaseURL: server.URL + "/api/v2"}
+			token, err := config.Exchange(context.Background(), tt.inputCode)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, "client", token.ClientID)
+				assert.Equal(t, tt.expectedToken, token.Token)
+				assert.Equal(t, tt.expectedScopes, token.Scopes)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestConfig_Authorize(t *testing.T) {
+	redirectURL := fmt.Sprintf("http://127.0.0.1:%d/callback", freePort(t))
+
+	// qiita redirects the user back to the redirect URL registered for the client.
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v2/oauth/authorize":
+			q := req.URL.Query()
+			assert.Equal(t, "client", q.Get("client_id"))
+			assert.Equal(t, "read_qiita write_qiita_team", q.Get("scope"))
+			http.Redirect(w, req, redirectURL+"?code=code&state="+url.QueryEscape(q.Get("state")), http.StatusFound)
+		case "/api/v2/access_tokens":
+			var body map[string]string
+			assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "code", body["code"])
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"client_id":"client","scopes":["read_qiita","write_qiita_team"],"token":"token"}`))
+		case "/api/v2/authenticated_user":
+			assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"id":"muiscript"}`))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer stub.Close()
+
+	config := &Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []Scope{ScopeReadQiita, ScopeWriteQiitaTeam},
+		RedirectURL:  redirectURL,
+		BaseURL:      stub.URL + "/api/v2",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	token, err := config.Authorize(ctx, func(authURL string) error {
+		// the browser follows the redirect to the callback server.
+		go func() {
+			resp, err := http.Get(authURL)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "token", token.Token)
+	assert.Equal(t, []Scope{ScopeReadQiita, ScopeWriteQiitaTeam}, token.Scopes)
+
+	cli, err := qiita.NewClient(token.Token, qiita.WithBaseURL(stub.URL+"/api/v2"))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	user, err := cli.GetAuthenticatedUser(ctx)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "muiscript", user.ID)
+}
+
+func TestCallbackServer(t *testing.T) {
+	tests := []struct {
+		desc          string
+		inputRawQuery string
+		inputPath     string
+
+		expectedStatus        int
+		expectedCode          string
+		expectedErrString     string
+		expectedStateMismatch bool
+	}{
+		{
+			desc:          "success",
+			inputRawQuery: "code=code&state=state",
+
+			expectedStatus: http.StatusOK,
+			expectedCode:   "code",
+		},
+		{
+			desc:          "failure-access_denied",
+			inputRawQuery: "error=access_denied&state=state",
+
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrString: "access_denied",
+		},
+		{
+			desc:          "failure-state_mismatch",
+			inputRawQuery: "code=code&state=forged",
+
+			expectedStatus:        http.StatusBadRequest,
+			expectedErrString:     "state mismatch",
+			expectedStateMismatch: true,
+		},
+		{
+			desc:          "failure-not_found",
+			inputRawQuery: "code=code&state=state",
+			inputPath:     "/other",
+
+			expectedStatus:    http.StatusNotFound,
+			expectedErrString: "deadline exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server, err := NewCallbackServer("http://127.0.0.1:0/callback", "state")
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			defer func() {
+				_ = server.Close()
+			}()
+
+			u := server.URL()
+			if tt.inputPath != "" {
+				u = strings.TrimSuffix(u, "/callback") + tt.inputPath
+			}
+			resp, err := http.Get(u + "?" + tt.inputRawQuery)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			_ = resp.Body.Close()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			code, err := server.Wait(ctx)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedCode, code)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+				assert.Equal(t, tt.expectedStateMismatch, errors.Is(err, ErrStateMismatch))
+				if tt.expectedStateMismatch {
+					assert.True(t, errors.Is(err, context.DeadlineExceeded))
+				}
+			}
+		})
+	}
+}
+
+func TestNewCallbackServer(t *testing.T) {
+	tests := []struct {
+		desc             string
+		inputRedirectURL string
+		inputState       string
+
+		expectedErrString string
+	}{
+		{
+			desc:             "failure-https",
+			inputRedirectURL: "https://127.0.0.1:0/callback",
+			inputState:       "state",
+
+			expectedErrString: "redirect URL should be http",
+		},
+		{
+			desc:             "failure-not_loopback",
+			inputRedirectURL: "http://example.com/callback",
+			inputState:       "state",
+
+			expectedErrString: "redirect URL should be a loopback address",
+		},
+		{
+			desc:             "failure-empty_state",
+			inputRedirectURL: "http://127.0.0.1:0/callback",
+			inputState:       "",
+
+			expectedErrString: "state should not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := NewCallbackServer(tt.inputRedirectURL, tt.inputState)
+			if !assert.NotNil(t, err) {
+				t.FailNow()
+			}
+
+			assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+		})
+	}
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,189 @@
+package qiita
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*options) error
+
+type options struct {
+	baseURL    string
+	httpClient *http.Client
+	userAgent  string
+	logger     Logger
+	timeout    time.Duration
+
+	tokenSource TokenSource
+	scopes      []Scope
+	rateLimiter *RateLimiter
+	cache       Cache
+	middlewares []Middleware
+
+	retryPolicy      *RetryPolicy
+	waitForRateLimit bool
+}
+
+var teamNameRx = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// WithBaseURL sets the base URL of qiita API, such as a local stub server.
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("base URL should be http or https. got '%s'", baseURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("base URL should have host. got '%s'", baseURL)
+		}
+		o.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithTeam sets the base URL to the qiita team API of provided team name (https://<team>.qiita.com/api/v2).
+func WithTeam(name string) Option {
+	return func(o *options) error {
+		if !teamNameRx.MatchString(name) {
+			return fmt.Errorf("team name should consist of lowercase alphanumerics and hyphens. got '%s'", name)
+		}
+		o.baseURL = fmt.Sprintf("https://%s.qiita.com/api/v2", name)
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) error {
+		if httpClient == nil {
+			return errors.New("http client should not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with requests.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) error {
+		if userAgent == "" {
+			return errors.New("user agent should not be empty")
+		}
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithLogger sets the structured logger such as *slog.Logger. Logs are discarded if it is nil.
+// A *log.Logger can be used through NewStdLogger.
+func WithLogger(logger Logger) Option {
+	return func(o *options) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for each request.
+// The http.Client is copied so that the timeout does not affect other users of it.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout should be positive. got %s", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares to the client as Client.Use does.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *options) error {
+		for _, m := range middlewares {
+			if m == nil {
+				return errors.New("middleware should not be nil")
+			}
+		}
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithCache sets the Cache which stores responses of GET requests to revalidate them by ETag.
+func WithCache(cache Cache) Option {
+	return func(o *options) error {
+		if cache == nil {
+			return errors.New("cache should not be nil")
+		}
+		o.cache = cache
+		return nil
+	}
+}
+
+// WithRateLimiter throttles requests of the client with the RateLimiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *options) error {
+		if limiter == nil {
+			return errors.New("rate limiter should not be nil")
+		}
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRetryPolicy retries requests failed by transient errors according to the RetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) error {
+		if policy == nil {
+			return errors.New("retry policy should not be nil")
+		}
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithWaitForRateLimit makes the client wait until the rate limit resets instead of sending a request which would be rejected.
+func WithWaitForRateLimit() Option {
+	return func(o *options) error {
+		o.waitForRateLimit = true
+		return nil
+	}
+}
+
+// WithTokenSource sets the TokenSource which supplies the access token instead of the one passed to NewClient.
+func WithTokenSource(tokenSource TokenSource) Option {
+	return func(o *options) error {
+		if tokenSource == nil {
+			return errors.New("token source should not be nil")
+		}
+		o.tokenSource = tokenSource
+		return nil
+	}
+}
+
+// WithScopes sets the scopes granted to the access token, which are checked before requests are sent.
+func WithScopes(scopes ...Scope) Option {
+	return func(o *options) error {
+		o.scopes = append([]Scope{}, scopes...)
+		return nil
+	}
+}
+
+// WithAccessToken uses the access token, such as the one issued by the oauth package, with its scopes.
+func WithAccessToken(token *AccessToken) Option {
+	return func(o *options) error {
+		if token == nil || token.Token == "" {
+			return errors.New("access token should not be empty")
+		}
+		o.tokenSource = StaticTokenSource(token.Token)
+		o.scopes = append([]Scope{}, token.Scopes...)
+		return nil
+	}
+}
@@ -0,0 +1,290 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// Project represents a project on Qiita Team.
+type Project struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Body           string `json:"body"`
+	RenderedBody   string `json:"rendered_body"`
+	Archived       bool   `json:"archived"`
+	ReactionsCount int    `json:"reactions_count"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ProjectDraft represents a project to be created or updated on Qiita Team.
+type ProjectDraft struct {
+	Name     string     `json:"name"`
+	Body     string     `json:"body"`
+	ItemTags []*ItemTag `json:"tags"`
+	Archived bool       `json:"archived"`
+}
+
+// ProjectsResponse represents a response from qiita API which includes multiple projects.
+type ProjectsResponse struct {
+	Projects   []*Project
+	PerPage    int
+	Page       int
+	FirstPage  int
+	LastPage   int
+	NextPage   int
+	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
+}
+
+func newProjectsResponse(projects []*Project, header http.Header, page, perPage int) (*ProjectsResponse, error) {
+	paginationInfo, err := extractPaginationInfo(header, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectsResponse{
+		Projects:   projects,
+		PerPage:    paginationInfo.PerPage,
+		Page:       paginationInfo.Page,
+		FirstPage:  paginationInfo.FirstPage,
+		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
+		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
+	}, nil
+}
+
+// GetProjects fetches the projects of the team.
+// This method requires authentication.
+//
+// GET /api/v2/projects
+// document: http://qiita.com/api/v2/docs#get-apiv2projects
+func (c *Client) GetProjects(ctx context.Context, page, perPage int) (*ProjectsResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, "projects", queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*Project
+	code, header, err := c.doRequest(req, &projects)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newProjectsResponse(projects, header, page, perPage)
+}
+
+// GetProject fetches the project having provided projectID.
+// This method requires authentication.
+//
+// GET /api/v2/projects/:project_id
+// document: http://qiita.com/api/v2/docs#get-apiv2projectsproject_id
+func (c *Client) GetProject(ctx context.Context, projectID int) (*Project, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("projects", strconv.Itoa(projectID)), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	code, _, err := c.doRequest(req, &project)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project with id '%d' not found: %w", projectID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &project, nil
+}
+
+// CreateProject creates the project.
+// This method requires authentication.
+//
+// POST /api/v2/projects
+// document: http://qiita.com/api/v2/docs#post-apiv2projects
+func (c *Client) CreateProject(ctx context.Context, name, body string, itemTags []*ItemTag, archived bool) (*Project, error) {
+	projectDraft := &ProjectDraft{Name: name, Body: body, ItemTags: itemTags, Archived: archived}
+	bodyBytes, err := json.Marshal(projectDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, "projects", nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	code, _, err := c.doRequest(req, &project)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &project, nil
+}
+
+// UpdateProject updates the project having provided projectID.
+// This method requires authentication.
+//
+// PATCH /api/v2/projects/:project_id
+// document: http://qiita.com/api/v2/docs#patch-apiv2projectsproject_id
+func (c *Client) UpdateProject(ctx context.Context, projectID int, name, body string, itemTags []*ItemTag, archived bool) (*Project, error) {
+	projectDraft := &ProjectDraft{Name: name, Body: body, ItemTags: itemTags, Archived: archived}
+	bodyBytes, err := json.Marshal(projectDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPatch, path.Join("projects", strconv.Itoa(projectID)), nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	code, _, err := c.doRequest(req, &project)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project with id '%d' not found: %w", projectID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &project, nil
+}
+
+// DeleteProject deletes the project having provided projectID.
+// This method requires authentication.
+//
+// DELETE /api/v2/projects/:project_id
+// document: http://qiita.com/api/v2/docs#delete-apiv2projectsproject_id
+func (c *Client) DeleteProject(ctx context.Context, projectID int) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("projects", strconv.Itoa(projectID)), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("project with id '%d' not found: %w", projectID, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetProjectComments fetches the comments posted on the project having provided projectID.
+// This method requires authentication.
+//
+// GET /api/v2/projects/:project_id/comments
+// document: http://qiita.com/api/v2/docs#get-apiv2projectsproject_idcomments
+func (c *Client) GetProjectComments(ctx context.Context, projectID int) ([]*Comment, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("projects", strconv.Itoa(projectID), "comments"), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []*Comment
+	code, _, err := c.doRequest(req, &comments)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project with id '%d' not found: %w", projectID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return comments, nil
+}
+
+// CreateProjectComment posts the comment on the project having provided projectID.
+// The comment can be updated or deleted with UpdateComment and DeleteComment.
+// This method requires authentication.
+//
+// POST /api/v2/projects/:project_id/comments
+// document: http://qiita.com/api/v2/docs#post-apiv2projectsproject_idcomments
+func (c *Client) CreateProjectComment(ctx context.Context, projectID int, body string) (*Comment, error) {
+	bodyBytes, err := json.Marshal(&CommentDraft{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, path.Join("projects", strconv.Itoa(projectID), "comments"), nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	code, _, err := c.doRequest(req, &comment)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project with id '%d' not found: %w", projectID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &comment, nil
+}
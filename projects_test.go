@@ -0,0 +1,648 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_GetProjects(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "GetProjects")
+
+	tests := []struct {
+		desc         string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedNames       []string
+		expectedArchived    []bool
+		expectedPage        int
+		expectedPerPage     int
+		expectedFirstPage   int
+		expectedLastPage    int
+		expectedNextPage    int
+		expectedTotalCount  int
+	}{
+		{
+			desc:         "success",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedNames:       []string{"qiita API クライアント", "社内勉強会"},
+			expectedArchived:    []bool{false, true},
+			expectedPage:        1,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    4,
+			expectedNextPage:    2,
+			expectedTotalCount:  7,
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-no_token",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			projectsResp, err := cli.GetProjects(context.Background(), tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var names []string
+				var archived []bool
+				for _, project := range projectsResp.Projects {
+					names = append(names, project.Name)
+					archived = append(archived, project.Archived)
+				}
+				assert.Equal(t, tt.expectedNames, names)
+				assert.Equal(t, tt.expectedArchived, archived)
+				assert.Equal(t, tt.expectedPage, projectsResp.Page)
+				assert.Equal(t, tt.expectedPerPage, projectsResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, projectsResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, projectsResp.LastPage)
+				assert.Equal(t, tt.expectedNextPage, projectsResp.NextPage)
+				assert.Equal(t, tt.expectedTotalCount, projectsResp.TotalCount)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetProject(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "GetProject")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod         string
+		expectedRequestPath    string
+		expectedErrString      string
+		expectedID             int
+		expectedName           string
+		expectedBody           string
+		expectedReactionsCount int
+		expectedCreatedAt      time.Time
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:         http.MethodGet,
+			expectedRequestPath:    "/projects/1",
+			expectedID:             1,
+			expectedName:           "qiita API クライアント",
+			expectedBody:           "API クライアントの開発",
+			expectedReactionsCount: 2,
+			expectedCreatedAt:      time.Date(2019, 4, 10, 10, 12, 31, 0, time.FixedZone("JST", 9*60*60)),
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/1",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			project, err := cli.GetProject(context.Background(), tt.inputProjectID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, project.ID)
+				assert.Equal(t, tt.expectedName, project.Name)
+				assert.True(t, strings.Contains(project.Body, tt.expectedBody))
+				assert.Equal(t, tt.expectedReactionsCount, project.ReactionsCount)
+				assert.True(t, project.CreatedAt.Equal(tt.expectedCreatedAt))
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateProject(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "CreateProject")
+
+	tests := []struct {
+		desc          string
+		inputName     string
+		inputBody     string
+		inputItemTags []*ItemTag
+		inputArchived bool
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedID          int
+		expectedName        string
+		expectedArchived    bool
+	}{
+		{
+			desc:          "success",
+			inputName:     "新卒研修",
+			inputBody:     "# 概要\n新卒研修の準備\n",
+			inputItemTags: []*ItemTag{{Name: "研修"}},
+			inputArchived: false,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects",
+			expectedID:          3,
+			expectedName:        "新卒研修",
+			expectedArchived:    false,
+		},
+		{
+			desc:          "failure-empty_name",
+			inputName:     "",
+			inputBody:     "# 概要\n新卒研修の準備\n",
+			inputItemTags: []*ItemTag{{Name: "研修"}},
+			inputArchived: false,
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:          "failure-no_token",
+			inputName:     "新卒研修",
+			inputBody:     "# 概要\n新卒研修の準備\n",
+			inputItemTags: []*ItemTag{{Name: "研修"}},
+			inputArchived: false,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			project, err := cli.CreateProject(context.Background(), tt.inputName, tt.inputBody, tt.inputItemTags, tt.inputArchived)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, project.ID)
+				assert.Equal(t, tt.expectedName, project.Name)
+				assert.Equal(t, tt.expectedArchived, project.Archived)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_UpdateProject(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "UpdateProject")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+		inputName      string
+		inputBody      string
+		inputItemTags  []*ItemTag
+		inputArchived  bool
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedName        string
+		expectedArchived    bool
+		expectedUpdatedAt   time.Time
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 3,
+			inputName:      "新卒研修",
+			inputBody:      "# 概要\n新卒研修の準備\n",
+			inputItemTags:  []*ItemTag{{Name: "研修"}},
+			inputArchived:  true,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/projects/3",
+			expectedName:        "新卒研修",
+			expectedArchived:    true,
+			expectedUpdatedAt:   time.Date(2019, 4, 18, 11, 15, 40, 0, time.FixedZone("JST", 9*60*60)),
+		},
+		{
+			desc:           "failure-empty_name",
+			inputProjectID: 3,
+			inputName:      "",
+			inputBody:      "# 概要\n新卒研修の準備\n",
+			inputItemTags:  []*ItemTag{{Name: "研修"}},
+			inputArchived:  true,
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/projects/3",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 3,
+			inputName:      "新卒研修",
+			inputBody:      "# 概要\n新卒研修の準備\n",
+			inputItemTags:  []*ItemTag{{Name: "研修"}},
+			inputArchived:  true,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/projects/3",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+			inputName:      "新卒研修",
+			inputBody:      "# 概要\n新卒研修の準備\n",
+			inputItemTags:  []*ItemTag{{Name: "研修"}},
+			inputArchived:  true,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/projects/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			project, err := cli.UpdateProject(context.Background(), tt.inputProjectID, tt.inputName, tt.inputBody, tt.inputItemTags, tt.inputArchived)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, project.Name)
+				assert.Equal(t, tt.expectedArchived, project.Archived)
+				assert.True(t, project.UpdatedAt.Equal(tt.expectedUpdatedAt))
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteProject(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "DeleteProject")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 3,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/3",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 3,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/3",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			err := cli.DeleteProject(context.Background(), tt.inputProjectID)
+			if tt.expectedErrString == "" {
+				assert.Nil(t, err)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetProjectComments(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "GetProjectComments")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedIDs         []string
+		expectedUserID      string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/1/comments",
+			expectedIDs:         []string{"3391f50c35f953abfc4f", "0a9e2f3e7b4d28c1ef50"},
+			expectedUserID:      "muiscript",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/1/comments",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/999/comments",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			comments, err := cli.GetProjectComments(context.Background(), tt.inputProjectID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var ids []string
+				for _, comment := range comments {
+					ids = append(ids, comment.ID)
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+				assert.Equal(t, tt.expectedUserID, comments[0].User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateProjectComment(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "projects", "CreateProjectComment")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+		inputBody      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod       string
+		expectedRequestPath  string
+		expectedErrString    string
+		expectedID           string
+		expectedBody         string
+		expectedRenderedBody string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+			inputBody:      "レビューお願いします",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:       http.MethodPost,
+			expectedRequestPath:  "/projects/1/comments",
+			expectedID:           "5c1d8e0f9a2b7e6d4c31",
+			expectedBody:         "レビューお願いします\n",
+			expectedRenderedBody: "<p>レビューお願いします</p>\n",
+		},
+		{
+			desc:           "failure-empty_body",
+			inputProjectID: 1,
+			inputBody:      "",
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/1/comments",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+			inputBody:      "レビューお願いします",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/1/comments",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+			inputBody:      "レビューお願いします",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/999/comments",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			comment, err := cli.CreateProjectComment(context.Background(), tt.inputProjectID, tt.inputBody)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, comment.ID)
+				assert.Equal(t, tt.expectedBody, comment.Body)
+				assert.Equal(t, tt.expectedRenderedBody, comment.RenderedBody)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
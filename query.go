@@ -0,0 +1,238 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Comparator is an operator to compare a numeric or date qualifier of Query.
+type Comparator string
+
+// Comparators of numeric and date qualifiers.
+const (
+	Equal              Comparator = ""
+	GreaterThan        Comparator = ">"
+	GreaterThanOrEqual Comparator = ">="
+	LessThan           Comparator = "<"
+	LessThanOrEqual    Comparator = "<="
+)
+
+// Query is a search query of qiita items built from keywords and qualifiers.
+// Terms are combined with AND, and Or adds a group of alternatives.
+// A query built from invalid alternatives reports the reason by Err, and SearchItems fails with it.
+//
+//	q := qiita.NewQuery().Tag("go").Stocks(qiita.GreaterThan, 10).Not(qiita.NewQuery().Tag("ruby"))
+//	q.String() // tag:go stocks:>10 -tag:ruby
+type Query struct {
+	terms []queryTerm
+	err   error
+}
+
+// queryTerm is either a keyword or qualifier, or a group of alternatives joined with OR.
+type queryTerm struct {
+	atom string
+	or   []*Query
+}
+
+// NewQuery returns an empty Query, which matches all the items.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Keyword adds a keyword searched in the title, body and code of items.
+func (q *Query) Keyword(keyword string) *Query {
+	return q.add(quoteQueryValue(keyword))
+}
+
+// Title adds a keyword searched in the title of items.
+func (q *Query) Title(keyword string) *Query {
+	return q.qualifier("title", keyword)
+}
+
+// Body adds a keyword searched in the body of items.
+func (q *Query) Body(keyword string) *Query {
+	return q.qualifier("body", keyword)
+}
+
+// Code adds a keyword searched in the code blocks of items.
+func (q *Query) Code(keyword string) *Query {
+	return q.qualifier("code", keyword)
+}
+
+// Tag restricts items to those having the tag.
+func (q *Query) Tag(tagID string) *Query {
+	return q.qualifier("tag", tagID)
+}
+
+// User restricts items to those created by the user.
+func (q *Query) User(userID string) *Query {
+	return q.qualifier("user", userID)
+}
+
+// Stocks restricts items by the number of stocks.
+func (q *Query) Stocks(cmp Comparator, count int) *Query {
+	return q.add("stocks:" + string(cmp) + strconv.Itoa(count))
+}
+
+// Created restricts items by the date they were created. Only the date of t in its location is used.
+func (q *Query) Created(cmp Comparator, t time.Time) *Query {
+	return q.add("created:" + string(cmp) + t.Format(createdDateLayout))
+}
+
+// Updated restricts items by the date they were updated. Only the date of t in its location is used.
+func (q *Query) Updated(cmp Comparator, t time.Time) *Query {
+	return q.add("updated:" + string(cmp) + t.Format(createdDateLayout))
+}
+
+// Raw adds a part of query as it is, such as a query string given by a user. Empty raw is ignored.
+// It is regarded as a single term, so raw including multiple terms should not be negated by Not.
+func (q *Query) Raw(raw string) *Query {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return q
+	}
+	return q.add(raw)
+}
+
+// Not adds the negation of each term in sub, which excludes the items matching any of them.
+func (q *Query) Not(sub *Query) *Query {
+	if sub != nil && sub.err != nil {
+		q.setErr(sub.err)
+		return q
+	}
+	q.terms = append(q.terms, sub.negate()...)
+	return q
+}
+
+// Or adds a group which matches the items matching any of alternatives.
+// Empty alternatives are ignored.
+// qiita search has no parentheses to group terms, so each alternative should consist of a single term
+// when there are two or more alternatives. Otherwise the group is not added and Err reports it.
+func (q *Query) Or(alternatives ...*Query) *Query {
+	var or []*Query
+	for _, alt := range alternatives {
+		if alt == nil {
+			continue
+		}
+		if alt.err != nil {
+			q.setErr(alt.err)
+			return q
+		}
+		if len(alt.terms) > 0 {
+			or = append(or, alt)
+		}
+	}
+	if len(or) > 1 {
+		for _, alt := range or {
+			if len(alt.terms) > 1 {
+				q.setErr(fmt.Errorf("alternative of OR should be a single term but got '%s'", alt.String()))
+				return q
+			}
+		}
+	}
+	switch len(or) {
+	case 0:
+		return q
+	case 1:
+		q.terms = append(q.terms, or[0].terms...)
+		return q
+	default:
+		q.terms = append(q.terms, queryTerm{or: or})
+		return q
+	}
+}
+
+// Err returns the error of the first invalid alternatives given to Or, or nil if the query is valid.
+func (q *Query) Err() error {
+	if q == nil {
+		return nil
+	}
+	return q.err
+}
+
+// String returns the query in the syntax of qiita search.
+// Groups rejected by Or are not included.
+func (q *Query) String() string {
+	if q == nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(q.terms))
+	for _, term := range q.terms {
+		if term.atom != "" {
+			parts = append(parts, term.atom)
+			continue
+		}
+
+		alts := make([]string, 0, len(term.or))
+		for _, alt := range term.or {
+			alts = append(alts, alt.String())
+		}
+		parts = append(parts, strings.Join(alts, " OR "))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (q *Query) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+func (q *Query) add(atom string) *Query {
+	q.terms = append(q.terms, queryTerm{atom: atom})
+	return q
+}
+
+func (q *Query) qualifier(name, value string) *Query {
+	return q.add(name + ":" + quoteQueryValue(value))
+}
+
+func (q *Query) negate() []queryTerm {
+	if q == nil {
+		return nil
+	}
+
+	var negated []queryTerm
+	for _, term := range q.terms {
+		if term.atom != "" {
+			if strings.HasPrefix(term.atom, "-") {
+				negated = append(negated, queryTerm{atom: term.atom[1:]})
+			} else {
+				negated = append(negated, queryTerm{atom: "-" + term.atom})
+			}
+			continue
+		}
+
+		for _, alt := range term.or {
+			negated = append(negated, alt.negate()...)
+		}
+	}
+	return negated
+}
+
+// quoteQueryValue quotes value if it contains characters which have meanings in the query syntax.
+func quoteQueryValue(value string) string {
+	if value != "" && value != "OR" && !strings.ContainsAny(value, " \t\r\n　\"\\:()") && !strings.HasPrefix(value, "-") {
+		return value
+	}
+
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(value) + `"`
+}
+
+// SearchItems fetches the items matching provided query. All the items are fetched if q is nil or empty.
+// It fails without sending a request if q has an error reported by Err.
+//
+// GET /api/v2/items
+// document: http://qiita.com/api/v2/docs#get-apiv2items
+func (c *Client) SearchItems(ctx context.Context, q *Query, page, perPage int) (*ItemsResponse, error) {
+	if err := q.Err(); err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	return c.getItems(ctx, q.String(), page, perPage)
+}
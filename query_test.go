@@ -0,0 +1,260 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuery_String(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		desc  string
+		query *Query
+
+		expected          string
+		expectedErrString string
+	}{
+		{
+			desc:  "empty",
+			query: NewQuery(),
+
+			expected: "",
+		},
+		{
+			desc:  "nil",
+			query: nil,
+
+			expected: "",
+		},
+		{
+			desc: "qualifiers",
+			query: NewQuery().
+				Keyword("goroutine").
+				Title("context").
+				Body("channel").
+				Code("select").
+				Tag("go").
+				User("muiscript").
+				Stocks(GreaterThan, 10).
+				Created(GreaterThanOrEqual, time.Date(2019, 1, 1, 0, 0, 0, 0, jst)).
+				Updated(LessThan, time.Date(2019, 4, 1, 0, 0, 0, 0, jst)),
+
+			expected: "goroutine title:context body:channel code:select tag:go user:muiscript stocks:>10 created:>=2019-01-01 updated:<2019-04-01",
+		},
+		{
+			desc:  "equal",
+			query: NewQuery().Stocks(Equal, 0).Created(Equal, time.Date(2019, 1, 1, 0, 0, 0, 0, jst)),
+
+			expected: "stocks:0 created:2019-01-01",
+		},
+		{
+			desc:  "quote",
+			query: NewQuery().Title("hello world").Keyword(`say "hi"`).Body(`C:\go`).Keyword("OR").Keyword("-v").Tag(""),
+
+			expected: `title:"hello world" "say \"hi\"" body:"C:\\go" "OR" "-v" tag:""`,
+		},
+		{
+			desc:  "not",
+			query: NewQuery().Tag("go").Not(NewQuery().Tag("ruby").User("spammer")),
+
+			expected: "tag:go -tag:ruby -user:spammer",
+		},
+		{
+			desc:  "double_not",
+			query: NewQuery().Not(NewQuery().Not(NewQuery().Tag("go"))),
+
+			expected: "tag:go",
+		},
+		{
+			desc:  "or",
+			query: NewQuery().Or(NewQuery().Tag("go"), NewQuery().Tag("golang")).Stocks(GreaterThanOrEqual, 100),
+
+			expected: "tag:go OR tag:golang stocks:>=100",
+		},
+		{
+			desc:  "or-single_alternative",
+			query: NewQuery().Or(NewQuery(), NewQuery().Tag("go"), nil),
+
+			expected: "tag:go",
+		},
+		{
+			desc:  "or-empty",
+			query: NewQuery().Tag("go").Or(),
+
+			expected: "tag:go",
+		},
+		{
+			desc:  "not_or",
+			query: NewQuery().User("muiscript").Not(NewQuery().Or(NewQuery().Tag("ruby"), NewQuery().Tag("php"))),
+
+			expected: "user:muiscript -tag:ruby -tag:php",
+		},
+		{
+			desc:  "or-nested_or",
+			query: NewQuery().Or(NewQuery().Or(NewQuery().Tag("a"), NewQuery().Tag("b")), NewQuery().Tag("c")),
+
+			expected: "tag:a OR tag:b OR tag:c",
+		},
+		{
+			desc:  "not_or-nested_or",
+			query: NewQuery().Not(NewQuery().Or(NewQuery().Or(NewQuery().Tag("a"), NewQuery().Tag("b")), NewQuery().Tag("c"))),
+
+			expected: "-tag:a -tag:b -tag:c",
+		},
+		{
+			desc:  "or-single_multi_term_alternative",
+			query: NewQuery().Or(NewQuery(), NewQuery().Tag("a").User("b")),
+
+			expected: "tag:a user:b",
+		},
+		{
+			desc:  "or-multi_term_alternative",
+			query: NewQuery().Tag("go").Or(NewQuery().Tag("a").User("b"), NewQuery().Tag("c")),
+
+			expected:          "tag:go",
+			expectedErrString: "alternative of OR should be a single term but got 'tag:a user:b'",
+		},
+		{
+			desc:  "not_or-multi_term_alternative",
+			query: NewQuery().Tag("go").Not(NewQuery().Or(NewQuery().Tag("a").User("b"), NewQuery().Tag("c"))),
+
+			expected:          "tag:go",
+			expectedErrString: "alternative of OR should be a single term but got 'tag:a user:b'",
+		},
+		{
+			desc:  "raw",
+			query: NewQuery().Raw(" tag:go user:muiscript ").Raw("").Stocks(LessThanOrEqual, 5),
+
+			expected: "tag:go user:muiscript stocks:<=5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.query.String())
+			if tt.expectedErrString == "" {
+				assert.Nil(t, tt.query.Err())
+			} else {
+				if !assert.NotNil(t, tt.query.Err()) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(tt.query.Err().Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", tt.query.Err().Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_SearchItems(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItems")
+
+	tests := []struct {
+		desc         string
+		inputQuery   *Query
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedItemsLen    int
+	}{
+		{
+			desc:         "success",
+			inputQuery:   NewQuery().Tag("go").Title("hello world").Stocks(GreaterThan, 10).Not(NewQuery().Tag("ruby")),
+			inputPage:    3,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items",
+			expectedRawQuery:    "page=3&per_page=2&query=tag%3Ago+title%3A%22hello+world%22+stocks%3A%3E10+-tag%3Aruby",
+			expectedItemsLen:    2,
+		},
+		{
+			desc:         "success-or",
+			inputQuery:   NewQuery().Or(NewQuery().User("foo"), NewQuery().User("bar")).Created(GreaterThanOrEqual, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+			inputPage:    3,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items",
+			expectedRawQuery:    "page=3&per_page=2&query=user%3Afoo+OR+user%3Abar+created%3A%3E%3D2019-01-01",
+			expectedItemsLen:    2,
+		},
+		{
+			desc:         "success-nil_query",
+			inputQuery:   nil,
+			inputPage:    3,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items",
+			expectedRawQuery:    "page=3&per_page=2",
+			expectedItemsLen:    2,
+		},
+		{
+			desc:         "failure-invalid_query",
+			inputQuery:   NewQuery().Or(NewQuery().Tag("a").User("b"), NewQuery().Tag("c")),
+			inputPage:    3,
+			inputPerPage: 2,
+
+			expectedErrString: "invalid query",
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputQuery:   NewQuery().Tag("go"),
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items",
+			expectedRawQuery:    "page=101&per_page=2&query=tag%3Ago",
+			expectedErrString:   "page parameter should be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			itemsResp, err := cli.SearchItems(context.Background(), tt.inputQuery, tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedItemsLen, len(itemsResp.Items))
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package qiita
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit represents the rate limit state reported by qiita API in response headers.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is the time when the current window ends.
+	Reset time.Time
+}
+
+// parseRateLimit extracts rate-limit, rate-remaining and rate-reset headers.
+// It returns nil when the headers are absent or malformed.
+func parseRateLimit(header http.Header) *RateLimit {
+	limit, err := strconv.Atoi(header.Get("rate-limit"))
+	if err != nil {
+		return nil
+	}
+	remaining, err := strconv.Atoi(header.Get("rate-remaining"))
+	if err != nil {
+		return nil
+	}
+	reset, err := strconv.ParseInt(header.Get("rate-reset"), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+}
+
+// RateLimit returns the rate limit state reported by the latest response.
+// It returns nil if no response with rate limit headers has been received yet.
+func (c *Client) RateLimit() *RateLimit {
+	c.rateLimitMu.RLock()
+	defer c.rateLimitMu.RUnlock()
+
+	if c.rateLimit == nil {
+		return nil
+	}
+	rl := *c.rateLimit
+	return &rl
+}
+
+func (c *Client) updateRateLimit(header http.Header) {
+	rl := parseRateLimit(header)
+	if rl == nil {
+		return
+	}
+
+	if c.RateLimiter != nil {
+		c.RateLimiter.observe(rl, time.Now())
+	}
+
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	c.rateLimit = rl
+}
+
+// waitForRateLimit blocks until the rate limit window resets if no request is left in the current window.
+// It does nothing unless WaitForRateLimit is enabled.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if !c.WaitForRateLimit {
+		return nil
+	}
+
+	rl := c.RateLimit()
+	if rl == nil || rl.Remaining > 0 {
+		return nil
+	}
+	wait := time.Until(rl.Reset)
+	if wait <= 0 {
+		return nil
+	}
+
+	return sleepContext(ctx, wait)
+}
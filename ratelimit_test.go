@@ -0,0 +1,108 @@
+package qiita
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItems")
+
+	cli, teardown := setup(t, mockFilesBaseDir, "success-header", "success-body", http.MethodGet, "/items", "page=3&per_page=2")
+	defer teardown()
+
+	assert.Nil(t, cli.RateLimit())
+
+	itemsResp, err := cli.GetItems(context.Background(), 3, 2)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	expected := &RateLimit{Limit: 60, Remaining: 57, Reset: time.Unix(1553416334, 0)}
+	assert.Equal(t, expected, cli.RateLimit())
+	assert.Equal(t, expected, itemsResp.RateLimit)
+}
+
+func TestClient_RateLimit_UpdatedOnError(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "items", "GetItem")
+
+	cli, teardown := setup(t, mockFilesBaseDir, "not_exist-header", "not_exist-body", http.MethodGet, "/items/nonexistent", "")
+	defer teardown()
+
+	_, err := cli.GetItem(context.Background(), "nonexistent")
+	if !assert.NotNil(t, err) {
+		t.FailNow()
+	}
+
+	expected := &RateLimit{Limit: 60, Remaining: 54, Reset: time.Unix(1549784675, 0)}
+	assert.Equal(t, expected, cli.RateLimit())
+}
+
+func TestClient_waitForRateLimit(t *testing.T) {
+	tests := []struct {
+		desc             string
+		waitForRateLimit bool
+		rateLimit        *RateLimit
+		ctxTimeout       time.Duration
+
+		expectedMinWait time.Duration
+		expectedErr     error
+	}{
+		{
+			desc:             "no_wait-disabled",
+			waitForRateLimit: false,
+			rateLimit:        &RateLimit{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)},
+		},
+		{
+			desc:             "no_wait-unknown",
+			waitForRateLimit: true,
+		},
+		{
+			desc:             "no_wait-remaining",
+			waitForRateLimit: true,
+			rateLimit:        &RateLimit{Limit: 60, Remaining: 1, Reset: time.Now().Add(time.Hour)},
+		},
+		{
+			desc:             "no_wait-already_reset",
+			waitForRateLimit: true,
+			rateLimit:        &RateLimit{Limit: 60, Remaining: 0, Reset: time.Now().Add(-time.Second)},
+		},
+		{
+			desc:             "wait",
+			waitForRateLimit: true,
+			rateLimit:        &RateLimit{Limit: 60, Remaining: 0, Reset: time.Now().Add(100 * time.Millisecond)},
+
+			expectedMinWait: 50 * time.Millisecond,
+		},
+		{
+			desc:             "failure-context_deadline",
+			waitForRateLimit: true,
+			rateLimit:        &RateLimit{Limit: 60, Remaining: 0, Reset: time.Now().Add(time.Hour)},
+			ctxTimeout:       10 * time.Millisecond,
+
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli := &Client{WaitForRateLimit: tt.waitForRateLimit, rateLimit: tt.rateLimit}
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			err := cli.waitForRateLimit(ctx)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.True(t, time.Since(start) >= tt.expectedMinWait)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// EmojiReaction represents an emoji reaction on qiita item, comment or project.
+// Emoji reactions are available only on Qiita Team.
+type EmojiReaction struct {
+	Name      string    `json:"name"`
+	ImageURL  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+	User      *User     `json:"user"`
+}
+
+// EmojiReactionDraft represents an emoji reaction to be added.
+type EmojiReactionDraft struct {
+	Name string `json:"name"`
+}
+
+// GetItemReactions fetches the emoji reactions on the item having provided itemID.
+// This method requires authentication.
+//
+// GET /api/v2/items/:item_id/reactions
+// document: http://qiita.com/api/v2/docs#get-apiv2itemsitem_idreactions
+func (c *Client) GetItemReactions(ctx context.Context, itemID string) ([]*EmojiReaction, error) {
+	return c.getReactions(ctx, "item", itemID, path.Join("items", itemID))
+}
+
+// CreateItemReaction adds the emoji reaction having provided name on the item having provided itemID.
+// This method requires authentication.
+//
+// POST /api/v2/items/:item_id/reactions
+// document: http://qiita.com/api/v2/docs#post-apiv2itemsitem_idreactions
+func (c *Client) CreateItemReaction(ctx context.Context, itemID string, name string) (*EmojiReaction, error) {
+	return c.createReaction(ctx, "item", itemID, path.Join("items", itemID), name)
+}
+
+// DeleteItemReaction removes the emoji reaction having provided name from the item having provided itemID.
+// This method requires authentication.
+//
+// DELETE /api/v2/items/:item_id/reactions/:reaction_name
+// document: http://qiita.com/api/v2/docs#delete-apiv2itemsitem_idreactionsreaction_name
+func (c *Client) DeleteItemReaction(ctx context.Context, itemID string, name string) error {
+	return c.deleteReaction(ctx, "item", itemID, path.Join("items", itemID), name)
+}
+
+// GetCommentReactions fetches the emoji reactions on the comment having provided commentID.
+// This method requires authentication.
+//
+// GET /api/v2/comments/:comment_id/reactions
+// document: http://qiita.com/api/v2/docs#get-apiv2commentscomment_idreactions
+func (c *Client) GetCommentReactions(ctx context.Context, commentID string) ([]*EmojiReaction, error) {
+	return c.getReactions(ctx, "comment", commentID, path.Join("comments", commentID))
+}
+
+// CreateCommentReaction adds the emoji reaction having provided name on the comment having provided commentID.
+// This method requires authentication.
+//
+// POST /api/v2/comments/:comment_id/reactions
+// document: http://qiita.com/api/v2/docs#post-apiv2commentscomment_idreactions
+func (c *Client) CreateCommentReaction(ctx context.Context, commentID string, name string) (*EmojiReaction, error) {
+	return c.createReaction(ctx, "comment", commentID, path.Join("comments", commentID), name)
+}
+
+// DeleteCommentReaction removes the emoji reaction having provided name from the comment having provided commentID.
+// This method requires authentication.
+//
+// DELETE /api/v2/comments/:comment_id/reactions/:reaction_name
+// document: http://qiita.com/api/v2/docs#delete-apiv2commentscomment_idreactionsreaction_name
+func (c *Client) DeleteCommentReaction(ctx context.Context, commentID string, name string) error {
+	return c.deleteReaction(ctx, "comment", commentID, path.Join("comments", commentID), name)
+}
+
+// GetProjectReactions fetches the emoji reactions on the project having provided projectID.
+// This method requires authentication.
+//
+// GET /api/v2/projects/:project_id/reactions
+// document: http://qiita.com/api/v2/docs#get-apiv2projectsproject_idreactions
+func (c *Client) GetProjectReactions(ctx context.Context, projectID int) ([]*EmojiReaction, error) {
+	id := strconv.Itoa(projectID)
+	return c.getReactions(ctx, "project", id, path.Join("projects", id))
+}
+
+// CreateProjectReaction adds the emoji reaction having provided name on the project having provided projectID.
+// This method requires authentication.
+//
+// POST /api/v2/projects/:project_id/reactions
+// document: http://qiita.com/api/v2/docs#post-apiv2projectsproject_idreactions
+func (c *Client) CreateProjectReaction(ctx context.Context, projectID int, name string) (*EmojiReaction, error) {
+	id := strconv.Itoa(projectID)
+	return c.createReaction(ctx, "project", id, path.Join("projects", id), name)
+}
+
+// DeleteProjectReaction removes the emoji reaction having provided name from the project having provided projectID.
+// This method requires authentication.
+//
+// DELETE /api/v2/projects/:project_id/reactions/:reaction_name
+// document: http://qiita.com/api/v2/docs#delete-apiv2projectsproject_idreactionsreaction_name
+func (c *Client) DeleteProjectReaction(ctx context.Context, projectID int, name string) error {
+	id := strconv.Itoa(projectID)
+	return c.deleteReaction(ctx, "project", id, path.Join("projects", id), name)
+}
+
+// getReactions fetches the emoji reactions on the resource at resourcePath. kind and id are used in error messages.
+func (c *Client) getReactions(ctx context.Context, kind, id, resourcePath string) ([]*EmojiReaction, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join(resourcePath, "reactions"), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var reactions []*EmojiReaction
+	code, _, err := c.doRequest(req, &reactions)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("%s with id '%s' not found: %w", kind, id, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return reactions, nil
+}
+
+func (c *Client) createReaction(ctx context.Context, kind, id, resourcePath, name string) (*EmojiReaction, error) {
+	bodyBytes, err := json.Marshal(&EmojiReactionDraft{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, path.Join(resourcePath, "reactions"), nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var reaction EmojiReaction
+	code, _, err := c.doRequest(req, &reaction)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. you may already have reacted with '%s' or it may not be a valid emoji: %w", name, err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("%s with id '%s' not found: %w", kind, id, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &reaction, nil
+}
+
+func (c *Client) deleteReaction(ctx context.Context, kind, id, resourcePath, name string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join(resourcePath, "reactions", name), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s with id '%s' not found or not reacted with '%s': %w", kind, id, name, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
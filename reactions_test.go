@@ -0,0 +1,791 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetItemReactions(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "GetItemReactions")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedNames       []string
+		expectedFirstUserID string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "b4ca1773580317e7112e",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions",
+			expectedNames:       []string{"+1", "tada"},
+			expectedFirstUserID: "tkdev",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "b4ca1773580317e7112e",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/nonexistent/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reactions, err := cli.GetItemReactions(context.Background(), tt.inputItemID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var names []string
+				for _, reaction := range reactions {
+					names = append(names, reaction.Name)
+				}
+				assert.Equal(t, tt.expectedNames, names)
+				assert.Equal(t, tt.expectedFirstUserID, reactions[0].User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateItemReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "CreateItemReaction")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+		inputName   string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedName        string
+		expectedImageURL    string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions",
+			expectedName:        "eyes",
+			expectedImageURL:    "https://cdn.qiita.com/emoji/twemoji/unicode/1f440.png",
+		},
+		{
+			desc:        "failure-already_reacted",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "already_reacted-header",
+			mockResponseBodyFile:   "already_reacted-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/items/nonexistent/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reaction, err := cli.CreateItemReaction(context.Background(), tt.inputItemID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, reaction.Name)
+				assert.Equal(t, tt.expectedImageURL, reaction.ImageURL)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteItemReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "DeleteItemReaction")
+
+	tests := []struct {
+		desc        string
+		inputItemID string
+		inputName   string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:        "success",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions/eyes",
+		},
+		{
+			desc:        "failure-not_reacted",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "not_reacted-header",
+			mockResponseBodyFile:   "not_reacted-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions/eyes",
+			expectedErrString:   "not reacted",
+		},
+		{
+			desc:        "failure-no_token",
+			inputItemID: "b4ca1773580317e7112e",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/b4ca1773580317e7112e/reactions/eyes",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:        "failure-not_exist",
+			inputItemID: "nonexistent",
+			inputName:   "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/items/nonexistent/reactions/eyes",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.DeleteItemReaction(context.Background(), tt.inputItemID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetCommentReactions(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "GetCommentReactions")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedNames       []string
+		expectedFirstUserID string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions",
+			expectedNames:       []string{"+1", "tada"},
+			expectedFirstUserID: "tkdev",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "87788bd04277521c7a66",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/comments/nonexistent/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reactions, err := cli.GetCommentReactions(context.Background(), tt.inputCommentID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var names []string
+				for _, reaction := range reactions {
+					names = append(names, reaction.Name)
+				}
+				assert.Equal(t, tt.expectedNames, names)
+				assert.Equal(t, tt.expectedFirstUserID, reactions[0].User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateCommentReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "CreateCommentReaction")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+		inputName      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedName        string
+		expectedImageURL    string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions",
+			expectedName:        "eyes",
+			expectedImageURL:    "https://cdn.qiita.com/emoji/twemoji/unicode/1f440.png",
+		},
+		{
+			desc:           "failure-already_reacted",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "already_reacted-header",
+			mockResponseBodyFile:   "already_reacted-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/comments/nonexistent/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reaction, err := cli.CreateCommentReaction(context.Background(), tt.inputCommentID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, reaction.Name)
+				assert.Equal(t, tt.expectedImageURL, reaction.ImageURL)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteCommentReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "DeleteCommentReaction")
+
+	tests := []struct {
+		desc           string
+		inputCommentID string
+		inputName      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions/eyes",
+		},
+		{
+			desc:           "failure-not_reacted",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_reacted-header",
+			mockResponseBodyFile:   "not_reacted-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions/eyes",
+			expectedErrString:   "not reacted",
+		},
+		{
+			desc:           "failure-no_token",
+			inputCommentID: "87788bd04277521c7a66",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/87788bd04277521c7a66/reactions/eyes",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputCommentID: "nonexistent",
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/comments/nonexistent/reactions/eyes",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.DeleteCommentReaction(context.Background(), tt.inputCommentID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetProjectReactions(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "GetProjectReactions")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedNames       []string
+		expectedFirstUserID string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/1/reactions",
+			expectedNames:       []string{"+1", "tada"},
+			expectedFirstUserID: "tkdev",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/1/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/projects/999/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reactions, err := cli.GetProjectReactions(context.Background(), tt.inputProjectID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				var names []string
+				for _, reaction := range reactions {
+					names = append(names, reaction.Name)
+				}
+				assert.Equal(t, tt.expectedNames, names)
+				assert.Equal(t, tt.expectedFirstUserID, reactions[0].User.ID)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateProjectReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "CreateProjectReaction")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+		inputName      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedName        string
+		expectedImageURL    string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/1/reactions",
+			expectedName:        "eyes",
+			expectedImageURL:    "https://cdn.qiita.com/emoji/twemoji/unicode/1f440.png",
+		},
+		{
+			desc:           "failure-already_reacted",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "already_reacted-header",
+			mockResponseBodyFile:   "already_reacted-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/1/reactions",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/1/reactions",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/projects/999/reactions",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			reaction, err := cli.CreateProjectReaction(context.Background(), tt.inputProjectID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, reaction.Name)
+				assert.Equal(t, tt.expectedImageURL, reaction.ImageURL)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteProjectReaction(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "reactions", "DeleteProjectReaction")
+
+	tests := []struct {
+		desc           string
+		inputProjectID int
+		inputName      string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:           "success",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/1/reactions/eyes",
+		},
+		{
+			desc:           "failure-not_reacted",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_reacted-header",
+			mockResponseBodyFile:   "not_reacted-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/1/reactions/eyes",
+			expectedErrString:   "not reacted",
+		},
+		{
+			desc:           "failure-no_token",
+			inputProjectID: 1,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/1/reactions/eyes",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:           "failure-not_exist",
+			inputProjectID: 999,
+			inputName:      "eyes",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/projects/999/reactions/eyes",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.DeleteProjectReaction(context.Background(), tt.inputProjectID, tt.inputName)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package qiita
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Response represents the metadata of a response from qiita API.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+
+	ETag      string
+	RequestID string
+	// Runtime is the processing time on the server reported by x-runtime header.
+	Runtime   time.Duration
+	RateLimit *RateLimit
+	// Links are the URLs in link header keyed by their rel, such as "next" and "last".
+	Links map[string]*url.URL
+}
+
+func newResponse(resp *http.Response) *Response {
+	r := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		ETag:       resp.Header.Get("etag"),
+		RequestID:  resp.Header.Get("x-request-id"),
+		RateLimit:  parseRateLimit(resp.Header),
+	}
+	if runtime := resp.Header.Get("x-runtime"); runtime != "" {
+		if d, err := time.ParseDuration(runtime + "s"); err == nil {
+			r.Runtime = d
+		}
+	}
+	if links, err := parseHeaderLink(resp.Header); err == nil && len(links) > 0 {
+		r.Links = links
+	}
+	return r
+}
+
+type responseContextKey struct{}
+
+// ContextWithResponse returns a context which makes the client record the metadata of the response into resp.
+// It is recorded for both successful and error responses.
+// If multiple requests are sent with the returned context, resp holds the metadata of the last one.
+//
+//	var resp qiita.Response
+//	item, err := cli.GetItem(qiita.ContextWithResponse(ctx, &resp), itemID)
+//	log.Printf("request id: %s", resp.RequestID)
+func ContextWithResponse(ctx context.Context, resp *Response) context.Context {
+	return context.WithValue(ctx, responseContextKey{}, resp)
+}
+
+// recordResponse stores the metadata of resp into the Response attached to ctx by ContextWithResponse.
+func recordResponse(ctx context.Context, resp *http.Response) {
+	r, ok := ctx.Value(responseContextKey{}).(*Response)
+	if !ok || r == nil {
+		return
+	}
+	*r = *newResponse(resp)
+}
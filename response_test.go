@@ -0,0 +1,114 @@
+package qiita
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestContextWithResponse(t *testing.T) {
+	tests := []struct {
+		desc             string
+		mockFilesBaseDir string
+		call             func(ctx context.Context, cli *Client) error
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedStatusCode  int
+		expectedETag        string
+		expectedRequestID   string
+		expectedRuntime     time.Duration
+		expectedRateLimit   *RateLimit
+		expectedNextLink    string
+	}{
+		{
+			desc:             "success-get_item",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItem"),
+			call: func(ctx context.Context, cli *Client) error {
+				_, err := cli.GetItem(ctx, "b4ca1773580317e7112e")
+				return err
+			},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/b4ca1773580317e7112e",
+			expectedStatusCode:  http.StatusOK,
+			expectedETag:        `W/"d3385656a2bcf1a8b99688cc8be3c650"`,
+			expectedRequestID:   "5c1c8577-0d67-498a-b8cd-24ff7eb06d42",
+			expectedRuntime:     268359 * time.Microsecond,
+		},
+		{
+			desc:             "success-get_items",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItems"),
+			call: func(ctx context.Context, cli *Client) error {
+				_, err := cli.GetItems(ctx, 3, 2)
+				return err
+			},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items",
+			expectedRawQuery:    "page=3&per_page=2",
+			expectedStatusCode:  http.StatusOK,
+			expectedETag:        `W/"eed352601db865dae16cdfc2567b74a5"`,
+			expectedRequestID:   "1d07cdaa-d383-4d7b-983f-d7290f25b651",
+			expectedRuntime:     263941 * time.Microsecond,
+			expectedRateLimit:   &RateLimit{Limit: 60, Remaining: 57, Reset: time.Unix(1553416334, 0)},
+			expectedNextLink:    "https://qiita.com/api/v2/items?page=4&per_page=2",
+		},
+		{
+			desc:             "failure-not_exist",
+			mockFilesBaseDir: path.Join("testdata", "responses", "items", "GetItem"),
+			call: func(ctx context.Context, cli *Client) error {
+				_, err := cli.GetItem(ctx, "nonexistent")
+				return err
+			},
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/items/nonexistent",
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestID:   "38dd249d-e222-4895-b8af-7bde309aa638",
+			expectedRuntime:     96367 * time.Microsecond,
+			expectedRateLimit:   &RateLimit{Limit: 60, Remaining: 54, Reset: time.Unix(1549784675, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, tt.mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			var resp Response
+			_ = tt.call(ContextWithResponse(context.Background(), &resp), cli)
+
+			assert.Equal(t, tt.expectedStatusCode, resp.StatusCode)
+			assert.Equal(t, tt.expectedETag, resp.ETag)
+			assert.Equal(t, tt.expectedRequestID, resp.RequestID)
+			assert.Equal(t, tt.expectedRuntime, resp.Runtime)
+			if tt.expectedRateLimit != nil {
+				assert.Equal(t, tt.expectedRateLimit, resp.RateLimit)
+			}
+			if tt.expectedNextLink != "" {
+				if assert.NotNil(t, resp.Links["next"]) {
+					assert.Equal(t, tt.expectedNextLink, resp.Links["next"].String())
+				}
+			} else {
+				assert.Nil(t, resp.Links["next"])
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package qiita
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests failed by transient errors.
+//
+// Only idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried
+// unless RetryNonIdempotent is set, so that items and comments are never posted twice.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry. It doubles on every retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	// A response asking to wait longer than this by Retry-After or rate-reset is returned without retry.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of each backoff which is randomized. It should be between 0 and 1.
+	Jitter float64
+	// RetryableStatuses is the set of response status codes which are retried.
+	RetryableStatuses map[int]bool
+	// RetryNonIdempotent allows retrying POST and PATCH requests.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy which retries up to 3 times on network errors,
+// 429 and 5xx responses other than 501.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: map[int]bool{
+			http.StatusTooManyRequests:     true,
+			http.StatusInternalServerError: true,
+			http.StatusBadGateway:          true,
+			http.StatusServiceUnavailable:  true,
+			http.StatusGatewayTimeout:      true,
+		},
+	}
+}
+
+func (p *RetryPolicy) maxAttempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return 1
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return p.MaxAttempts
+	default:
+		if p.RetryNonIdempotent {
+			return p.MaxAttempts
+		}
+		return 1
+	}
+}
+
+// shouldRetry reports whether the response is worth retrying.
+// A 403 response with no remaining rate limit is regarded as retryable because it resolves on rate-reset.
+func (p *RetryPolicy) shouldRetry(resp *http.Response) bool {
+	if p.RetryableStatuses[resp.StatusCode] {
+		return true
+	}
+	return resp.StatusCode == http.StatusForbidden && resp.Header.Get("rate-remaining") == "0"
+}
+
+// backoff returns the wait before the (attempt+1)-th attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// retryAfter returns the wait requested by the server through Retry-After or rate-reset headers.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t), true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusForbidden {
+		if rl := parseRateLimit(resp.Header); rl != nil && rl.Remaining == 0 {
+			return time.Until(rl.Reset), true
+		}
+	}
+	return 0, false
+}
+
+// send sends the request, retrying it according to the client's RetryPolicy.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	policy := c.RetryPolicy
+	maxAttempts := policy.maxAttempts(req)
+
+	for attempt := 1; ; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := c.doer().Do(req)
+		if attempt >= maxAttempts {
+			return resp, err
+		}
+
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			wait = policy.backoff(attempt)
+		case policy.shouldRetry(resp):
+			c.updateRateLimit(resp.Header)
+			wait = policy.backoff(attempt)
+			if d, ok := retryAfter(resp); ok {
+				if policy.MaxBackoff > 0 && d > policy.MaxBackoff {
+					return resp, nil
+				}
+				wait = d
+			}
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		c.logger().Warn("retrying qiita request", "method", req.Method, "path", redactPath(req.URL), "attempt", attempt, "wait", wait)
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepContext blocks for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
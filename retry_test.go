@@ -0,0 +1,214 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer returns a server which responds with failStatus to the first failures requests
+// and with 2xx afterwards. The number of received requests is counted in calls.
+func newFlakyServer(t *testing.T, failures int32, failStatus int, failHeaders map[string]string, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+
+		b, _ := ioutil.ReadAll(req.Body)
+		if req.Method == http.MethodPost && !assert.Equal(t, `{"body":"test comment"}`, string(b)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if n <= failures {
+			for k, v := range failHeaders {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(failStatus)
+			_, _ = w.Write([]byte(`{"message":"Service Unavailable","type":"service_unavailable"}`))
+			return
+		}
+
+		if req.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"3391f50c35f953abfc4f","body":"test comment"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"b4ca1773580317e7112e"}`))
+	}))
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		desc        string
+		policy      *RetryPolicy
+		failures    int32
+		failStatus  int
+		failHeaders map[string]string
+		post        bool
+
+		expectedCalls     int32
+		expectedErrStatus int
+	}{
+		{
+			desc:       "success-no_policy",
+			policy:     nil,
+			failures:   1,
+			failStatus: http.StatusServiceUnavailable,
+
+			expectedCalls:     1,
+			expectedErrStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:       "success-recovered",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}},
+			failures:   2,
+			failStatus: http.StatusServiceUnavailable,
+
+			expectedCalls: 3,
+		},
+		{
+			desc:       "failure-exhausted",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}},
+			failures:   5,
+			failStatus: http.StatusServiceUnavailable,
+
+			expectedCalls:     3,
+			expectedErrStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:       "failure-not_retryable_status",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}},
+			failures:   1,
+			failStatus: http.StatusNotFound,
+
+			expectedCalls:     1,
+			expectedErrStatus: http.StatusNotFound,
+		},
+		{
+			desc:       "success-rate_limited",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Second},
+			failures:   1,
+			failStatus: http.StatusForbidden,
+			failHeaders: map[string]string{
+				"rate-limit":     "60",
+				"rate-remaining": "0",
+				"rate-reset":     "0",
+			},
+
+			expectedCalls: 2,
+		},
+		{
+			desc:        "failure-retry_after_too_long",
+			policy:      &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Second, RetryableStatuses: map[int]bool{http.StatusTooManyRequests: true}},
+			failures:    1,
+			failStatus:  http.StatusTooManyRequests,
+			failHeaders: map[string]string{"Retry-After": "120"},
+
+			expectedCalls:     1,
+			expectedErrStatus: http.StatusTooManyRequests,
+		},
+		{
+			desc:       "failure-non_idempotent",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}},
+			failures:   1,
+			failStatus: http.StatusServiceUnavailable,
+			post:       true,
+
+			expectedCalls:     1,
+			expectedErrStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:       "success-non_idempotent_allowed",
+			policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}, RetryNonIdempotent: true},
+			failures:   1,
+			failStatus: http.StatusServiceUnavailable,
+			post:       true,
+
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var calls int32
+			server := newFlakyServer(t, tt.failures, tt.failStatus, tt.failHeaders, &calls)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			cli := &Client{
+				URL:         serverURL,
+				HTTPClient:  server.Client(),
+				Logger:      NewStdLogger(log.New(ioutil.Discard, "", 0)),
+				RetryPolicy: tt.policy,
+			}
+
+			var err error
+			if tt.post {
+				_, err = cli.CreateItemComment(context.Background(), "b4ca1773580317e7112e", "test comment")
+			} else {
+				_, err = cli.GetItem(context.Background(), "b4ca1773580317e7112e")
+			}
+
+			assert.Equal(t, tt.expectedCalls, atomic.LoadInt32(&calls))
+			if tt.expectedErrStatus == 0 {
+				assert.Nil(t, err)
+			} else {
+				var apiErr *APIError
+				if !assert.True(t, errors.As(err, &apiErr)) {
+					t.FailNow()
+				}
+				assert.Equal(t, tt.expectedErrStatus, apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestClient_RetryPolicy_ContextCanceled(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(t, 5, http.StatusServiceUnavailable, nil, &calls)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	cli := &Client{
+		URL:         serverURL,
+		HTTPClient:  server.Client(),
+		Logger:      NewStdLogger(log.New(ioutil.Discard, "", 0)),
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Hour, RetryableStatuses: map[int]bool{http.StatusServiceUnavailable: true}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := cli.GetItem(ctx, "b4ca1773580317e7112e")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(5))
+
+	uncapped := &RetryPolicy{BaseBackoff: 100 * time.Millisecond}
+	assert.Equal(t, 100*time.Millisecond, uncapped.backoff(1))
+	assert.Equal(t, 400*time.Millisecond, uncapped.backoff(3))
+	assert.Equal(t, 1600*time.Millisecond, uncapped.backoff(5))
+	assert.True(t, uncapped.backoff(100) > 0)
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		d := policy.backoff(1)
+		assert.True(t, 50*time.Millisecond <= d && d <= 100*time.Millisecond)
+	}
+}
@@ -0,0 +1,140 @@
+package qiita
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Scope is a permission granted to an access token.
+type Scope string
+
+// Scopes of qiita API v2.
+const (
+	ScopeReadQiita      Scope = "read_qiita"
+	ScopeWriteQiita     Scope = "write_qiita"
+	ScopeReadQiitaTeam  Scope = "read_qiita_team"
+	ScopeWriteQiitaTeam Scope = "write_qiita_team"
+)
+
+type endpointScope struct {
+	method   string
+	endpoint string
+	scope    Scope
+}
+
+// endpointScopes declares the scope required by each endpoint. Endpoints not listed require no scope.
+// On Qiita Team, read_qiita and write_qiita are replaced with read_qiita_team and write_qiita_team.
+var endpointScopes = []endpointScope{
+	{http.MethodGet, "/authenticated_user", ScopeReadQiita},
+	{http.MethodGet, "/authenticated_user/items", ScopeReadQiita},
+	{http.MethodGet, "/users/:user_id/following", ScopeReadQiita},
+	{http.MethodPut, "/users/:user_id/following", ScopeWriteQiita},
+	{http.MethodDelete, "/users/:user_id/following", ScopeWriteQiita},
+
+	{http.MethodPost, "/items", ScopeWriteQiita},
+	{http.MethodPatch, "/items/:item_id", ScopeWriteQiita},
+	{http.MethodDelete, "/items/:item_id", ScopeWriteQiita},
+	{http.MethodPost, "/items/:item_id/comments", ScopeWriteQiita},
+	{http.MethodGet, "/items/:item_id/stock", ScopeReadQiita},
+	{http.MethodPut, "/items/:item_id/stock", ScopeWriteQiita},
+	{http.MethodDelete, "/items/:item_id/stock", ScopeWriteQiita},
+	{http.MethodGet, "/items/:item_id/like", ScopeReadQiita},
+	{http.MethodPut, "/items/:item_id/like", ScopeWriteQiita},
+	{http.MethodDelete, "/items/:item_id/like", ScopeWriteQiita},
+
+	{http.MethodPatch, "/comments/:comment_id", ScopeWriteQiita},
+	{http.MethodDelete, "/comments/:comment_id", ScopeWriteQiita},
+	{http.MethodPut, "/comments/:comment_id/thank", ScopeWriteQiita},
+	{http.MethodDelete, "/comments/:comment_id/thank", ScopeWriteQiita},
+
+	{http.MethodGet, "/tags/:tag_id/following", ScopeReadQiita},
+	{http.MethodPut, "/tags/:tag_id/following", ScopeWriteQiita},
+	{http.MethodDelete, "/tags/:tag_id/following", ScopeWriteQiita},
+
+	{http.MethodGet, "/items/:item_id/reactions", ScopeReadQiitaTeam},
+	{http.MethodPost, "/items/:item_id/reactions", ScopeWriteQiitaTeam},
+	{http.MethodDelete, "/items/:item_id/reactions/:reaction_name", ScopeWriteQiitaTeam},
+	{http.MethodGet, "/comments/:comment_id/reactions", ScopeReadQiitaTeam},
+	{http.MethodPost, "/comments/:comment_id/reactions", ScopeWriteQiitaTeam},
+	{http.MethodDelete, "/comments/:comment_id/reactions/:reaction_name", ScopeWriteQiitaTeam},
+
+	{http.MethodGet, "/templates", ScopeReadQiitaTeam},
+	{http.MethodGet, "/templates/:template_id", ScopeReadQiitaTeam},
+	{http.MethodPost, "/templates", ScopeWriteQiitaTeam},
+	{http.MethodPatch, "/templates/:template_id", ScopeWriteQiitaTeam},
+	{http.MethodDelete, "/templates/:template_id", ScopeWriteQiitaTeam},
+	{http.MethodPost, "/expanded_templates", ScopeReadQiitaTeam},
+
+	{http.MethodGet, "/projects", ScopeReadQiitaTeam},
+	{http.MethodGet, "/projects/:project_id", ScopeReadQiitaTeam},
+	{http.MethodPost, "/projects", ScopeWriteQiitaTeam},
+	{http.MethodPatch, "/projects/:project_id", ScopeWriteQiitaTeam},
+	{http.MethodDelete, "/projects/:project_id", ScopeWriteQiitaTeam},
+	{http.MethodGet, "/projects/:project_id/comments", ScopeReadQiitaTeam},
+	{http.MethodPost, "/projects/:project_id/comments", ScopeWriteQiitaTeam},
+	{http.MethodGet, "/projects/:project_id/reactions", ScopeReadQiitaTeam},
+	{http.MethodPost, "/projects/:project_id/reactions", ScopeWriteQiitaTeam},
+	{http.MethodDelete, "/projects/:project_id/reactions/:reaction_name", ScopeWriteQiitaTeam},
+
+	{http.MethodGet, "/groups", ScopeReadQiitaTeam},
+	{http.MethodGet, "/groups/:url_name", ScopeReadQiitaTeam},
+	{http.MethodGet, "/groups/:url_name/members", ScopeReadQiitaTeam},
+}
+
+// checkScope returns an *InsufficientScopeError if the scopes of the client are known and lack the one required by the endpoint.
+func (c *Client) checkScope(method, relativePath string) error {
+	if c.Scopes == nil {
+		return nil
+	}
+
+	es, ok := lookupEndpointScope(method, relativePath)
+	if !ok {
+		return nil
+	}
+	scope := es.scope
+	if isTeamURL(c.URL) {
+		switch scope {
+		case ScopeReadQiita:
+			scope = ScopeReadQiitaTeam
+		case ScopeWriteQiita:
+			scope = ScopeWriteQiitaTeam
+		}
+	}
+
+	for _, s := range c.Scopes {
+		if s == scope {
+			return nil
+		}
+	}
+	return &InsufficientScopeError{Method: es.method, Endpoint: es.endpoint, Scope: scope}
+}
+
+func lookupEndpointScope(method, relativePath string) (endpointScope, bool) {
+	segments := strings.Split(strings.Trim(relativePath, "/"), "/")
+	for _, es := range endpointScopes {
+		if es.method == method && matchEndpoint(es.endpoint, segments) {
+			return es, true
+		}
+	}
+	return endpointScope{}, false
+}
+
+// matchEndpoint reports whether the path segments match the endpoint, in which a segment starting with ':' matches any segment.
+func matchEndpoint(endpoint string, segments []string) bool {
+	patterns := strings.Split(strings.Trim(endpoint, "/"), "/")
+	if len(patterns) != len(segments) {
+		return false
+	}
+	for i, pattern := range patterns {
+		if !strings.HasPrefix(pattern, ":") && pattern != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isTeamURL reports whether u is the API of Qiita Team (https://<team>.qiita.com/api/v2).
+func isTeamURL(u *url.URL) bool {
+	return u != nil && strings.HasSuffix(u.Hostname(), ".qiita.com")
+}
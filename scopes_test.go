@@ -0,0 +1,158 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestClient_checkScope(t *testing.T) {
+	tests := []struct {
+		desc              string
+		inputURL          string
+		inputScopes       []Scope
+		inputMethod       string
+		inputRelativePath string
+
+		expectedErrString string
+	}{
+		{
+			desc:              "success-granted",
+			inputURL:          BaseURL,
+			inputScopes:       []Scope{ScopeReadQiita, ScopeWriteQiita},
+			inputMethod:       http.MethodPut,
+			inputRelativePath: "items/b4ca1773580317e7112e/stock",
+		},
+		{
+			desc:              "success-unknown_scopes",
+			inputURL:          BaseURL,
+			inputScopes:       nil,
+			inputMethod:       http.MethodPost,
+			inputRelativePath: "items",
+		},
+		{
+			desc:              "success-no_scope_required",
+			inputURL:          BaseURL,
+			inputScopes:       []Scope{},
+			inputMethod:       http.MethodGet,
+			inputRelativePath: "items/b4ca1773580317e7112e",
+		},
+		{
+			desc:              "failure-write",
+			inputURL:          BaseURL,
+			inputScopes:       []Scope{ScopeReadQiita},
+			inputMethod:       http.MethodPost,
+			inputRelativePath: "items",
+
+			expectedErrString: "POST /items: access token is not granted the required scope 'write_qiita'",
+		},
+		{
+			desc:              "failure-path_parameter",
+			inputURL:          BaseURL,
+			inputScopes:       []Scope{ScopeReadQiita},
+			inputMethod:       http.MethodPut,
+			inputRelativePath: "users/muiscript/following",
+
+			expectedErrString: "PUT /users/:user_id/following: access token is not granted the required scope 'write_qiita'",
+		},
+		{
+			desc:              "failure-read",
+			inputURL:          BaseURL,
+			inputScopes:       []Scope{ScopeWriteQiita},
+			inputMethod:       http.MethodGet,
+			inputRelativePath: "authenticated_user",
+
+			expectedErrString: "required scope 'read_qiita'",
+		},
+		{
+			desc:              "success-team",
+			inputURL:          "https://increments.qiita.com/api/v2",
+			inputScopes:       []Scope{ScopeReadQiitaTeam, ScopeWriteQiitaTeam},
+			inputMethod:       http.MethodPut,
+			inputRelativePath: "items/b4ca1773580317e7112e/stock",
+		},
+		{
+			desc:              "failure-team",
+			inputURL:          "https://increments.qiita.com/api/v2",
+			inputScopes:       []Scope{ScopeReadQiita, ScopeWriteQiita},
+			inputMethod:       http.MethodPut,
+			inputRelativePath: "items/b4ca1773580317e7112e/stock",
+
+			expectedErrString: "required scope 'write_qiita_team'",
+		},
+		{
+			desc:              "failure-team_only",
+			inputURL:          "https://increments.qiita.com/api/v2",
+			inputScopes:       []Scope{ScopeReadQiitaTeam},
+			inputMethod:       http.MethodDelete,
+			inputRelativePath: "projects/1/reactions/+1",
+
+			expectedErrString: "DELETE /projects/:project_id/reactions/:reaction_name: access token is not granted the required scope 'write_qiita_team'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			u, err := url.Parse(tt.inputURL)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			cli := &Client{URL: u, Scopes: tt.inputScopes}
+
+			err = cli.checkScope(tt.inputMethod, tt.inputRelativePath)
+			if tt.expectedErrString == "" {
+				assert.Nil(t, err)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, errors.Is(err, ErrInsufficientScope))
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_InsufficientScope(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	token := &AccessToken{ClientID: "client", Scopes: []Scope{ScopeReadQiita}, Token: "token"}
+	cli, err := NewClient("", WithBaseURL(server.URL), WithAccessToken(token))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	_, err = cli.CreateItem(context.Background(), "title", "body", nil, false, false)
+	assert.True(t, errors.Is(err, ErrInsufficientScope))
+	assert.False(t, errors.Is(err, ErrForbidden))
+
+	var scopeErr *InsufficientScopeError
+	if assert.True(t, errors.As(err, &scopeErr)) {
+		assert.Equal(t, ScopeWriteQiita, scopeErr.Scope)
+	}
+	assert.False(t, requested, "request should not be sent")
+}
+
+func TestEndpointScopes(t *testing.T) {
+	for _, es := range endpointScopes {
+		assert.True(t, strings.HasPrefix(es.endpoint, "/"), es.endpoint)
+		assert.NotEqual(t, Scope(""), es.scope, es.endpoint)
+
+		found, ok := lookupEndpointScope(es.method, es.endpoint)
+		if assert.True(t, ok, es.endpoint) {
+			assert.Equal(t, es, found, "%s %s is shadowed by %s %s", es.method, es.endpoint, found.method, found.endpoint)
+		}
+	}
+}
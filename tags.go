@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"strconv"
+	"strings"
 )
 
 // Tag represents tag which can be attached to a qiita item.
@@ -15,6 +17,18 @@ type Tag struct {
 	FollowersCount int    `json:"followers_count"`
 }
 
+// TagSort represents the order of tags fetched by GetTags.
+type TagSort string
+
+const (
+	// TagSortDefault leaves the order to qiita API, which sorts tags by the number of items.
+	TagSortDefault TagSort = ""
+	// TagSortCount sorts tags by the number of items in descending order.
+	TagSortCount TagSort = "count"
+	// TagSortName sorts tags by name in ascending order.
+	TagSortName TagSort = "name"
+)
+
 // TagsResponse represents a response from qiita API which includes multiple tags.
 type TagsResponse struct {
 	Tags       []*Tag
@@ -22,7 +36,10 @@ type TagsResponse struct {
 	Page       int
 	FirstPage  int
 	LastPage   int
+	NextPage   int
 	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
 }
 
 func newTagsResponse(tags []*Tag, header http.Header, page, perPage int) (*TagsResponse, error) {
@@ -37,7 +54,10 @@ func newTagsResponse(tags []*Tag, header http.Header, page, perPage int) (*TagsR
 		Page:       paginationInfo.Page,
 		FirstPage:  paginationInfo.FirstPage,
 		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
 		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
 	}, nil
 }
 
@@ -54,35 +74,81 @@ func (c *Client) GetTag(ctx context.Context, tagID string) (*Tag, error) {
 	var tag Tag
 	code, _, err := c.doRequest(req, &tag)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("tag with id '%s' not found: %w", tagID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return &tag, nil
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("tag with id '%s' not found (status = %d)", tagID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return &tag, nil
 }
 
-// GetTags fetches all the tags.
+// GetTags fetches all the tags in the order specified by sort.
 //
 // GET /api/v2/tags
 // document: http://qiita.com/api/v2/docs#get-apiv2tags
-func (c *Client) GetTags(ctx context.Context) ([]*Tag, error) {
-	// TODO: implement
-	return nil, nil
+func (c *Client) GetTags(ctx context.Context, page, perPage int, sort TagSort) (*TagsResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	switch sort {
+	case TagSortDefault:
+	case TagSortCount, TagSortName:
+		queries["sort"] = string(sort)
+	default:
+		return nil, fmt.Errorf("sort parameter should be '%s' or '%s', but got '%s'", TagSortCount, TagSortName, sort)
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, "tags", queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*Tag
+	_, header, err := c.doRequest(req, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTagsResponse(tags, header, page, perPage)
 }
 
 // GetTagItems fetches the items which the tag having provided tagID is attached.
 //
 // GET /api/v2/tags/:tag_id/items
 // document: http://qiita.com/api/v2/docs#get-apiv2tagstag_iditems
-func (c *Client) GetTagItems(ctx context.Context, tagID string) ([]*Item, error) {
-	// TODO: implement
-	return nil, nil
+func (c *Client) GetTagItems(ctx context.Context, tagID string, page, perPage int) (*ItemsResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("tags", tagID, "items"), queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*Item
+	code, header, err := c.doRequest(req, &items)
+	if err != nil {
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("tag with id '%s' not found: %w", tagID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newItemsResponse(items, header, page, perPage)
 }
 
 // IsFollowingTag returns true if the authenticated user is following the tag having provided tagID.
@@ -91,8 +157,24 @@ func (c *Client) GetTagItems(ctx context.Context, tagID string) ([]*Item, error)
 // GET /api/v2/tags/:tag_id/following
 // document: http://qiita.com/api/v2/docs#get-apiv2tagstag_idfollowing
 func (c *Client) IsFollowingTag(ctx context.Context, tagID string) (bool, error) {
-	// TODO: implement
-	return false, nil
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("tags", tagID, "following"), nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return false, nil
+		default:
+			return false, err
+		}
+	}
+
+	return true, nil
 }
 
 // FollowTag follows the tag having provided tagID.
@@ -101,7 +183,25 @@ func (c *Client) IsFollowingTag(ctx context.Context, tagID string) (bool, error)
 // PUT /api/v2/tags/:tag_id/following
 // document: http://qiita.com/api/v2/docs#put-apiv2tagstag_idfollowing
 func (c *Client) FollowTag(ctx context.Context, tagID string) error {
-	// TODO: implement
+	req, err := c.newRequest(ctx, http.MethodPut, path.Join("tags", tagID, "following"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("not found. tag with id '%s' does not exist: %w", tagID, err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have followed tag with id '%s': %w", tagID, err)
+		default:
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -111,6 +211,77 @@ func (c *Client) FollowTag(ctx context.Context, tagID string) error {
 // DELETE /api/v2/tags/:tag_id/following
 // document: http://qiita.com/api/v2/docs#delete-apiv2tagstag_idfollowing
 func (c *Client) UnfollowTag(ctx context.Context, tagID string) error {
-	// TODO: implement
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("tags", tagID, "following"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("not found. tag with id '%s' does not exist: %w", tagID, err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have not followed tag with id '%s': %w", tagID, err)
+		default:
+			return err
+		}
+	}
+
 	return nil
 }
+
+// SyncFollowingTags makes the authenticated user follow exactly the tags having provided tag IDs.
+// It follows the desired tags which are not followed yet and unfollows the followed tags which are not desired.
+// Tag IDs are compared case-insensitively as qiita does.
+// It returns the tag IDs actually followed and unfollowed, which are valid even if an error occurs on the way.
+// This method requires authentication.
+func (c *Client) SyncFollowingTags(ctx context.Context, desired []string) (followed, unfollowed []string, err error) {
+	user, err := c.Whoami(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var current []string
+	following := make(map[string]bool)
+	it := c.IterateUserFollowingTags(user.ID, PerPageMax)
+	for it.Next(ctx) {
+		tagID := it.Value().ID
+		current = append(current, tagID)
+		following[strings.ToLower(tagID)] = true
+	}
+	if err := it.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, tagID := range desired {
+		key := strings.ToLower(tagID)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+
+		if following[key] {
+			continue
+		}
+		if err := c.FollowTag(ctx, tagID); err != nil {
+			return followed, unfollowed, err
+		}
+		followed = append(followed, tagID)
+	}
+
+	for _, tagID := range current {
+		if wanted[strings.ToLower(tagID)] {
+			continue
+		}
+		if err := c.UnfollowTag(ctx, tagID); err != nil {
+			return followed, unfollowed, err
+		}
+		unfollowed = append(unfollowed, tagID)
+	}
+
+	return followed, unfollowed, nil
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -82,3 +84,581 @@ func TestClient_GetTag(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTags(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tags", "GetTags")
+
+	tests := []struct {
+		desc         string
+		inputPage    int
+		inputPerPage int
+		inputSort    TagSort
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedPage        int
+		expectedPerPage     int
+		expectedFirstPage   int
+		expectedLastPage    int
+		expectedNextPage    int
+		expectedTotalCount  int
+		expectedTagIDs      []string
+	}{
+		{
+			desc:         "success-sort_by_count",
+			inputPage:    1,
+			inputPerPage: 2,
+			inputSort:    TagSortCount,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags",
+			expectedRawQuery:    "page=1&per_page=2&sort=count",
+			expectedPage:        1,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    100,
+			expectedNextPage:    2,
+			expectedTotalCount:  121141,
+			expectedTagIDs:      []string{"JavaScript", "Python"},
+		},
+		{
+			desc:         "success-sort_by_name",
+			inputPage:    2,
+			inputPerPage: 2,
+			inputSort:    TagSortName,
+
+			mockResponseHeaderFile: "success_sort_name-header",
+			mockResponseBodyFile:   "success_sort_name-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags",
+			expectedRawQuery:    "page=2&per_page=2&sort=name",
+			expectedPage:        2,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    100,
+			expectedNextPage:    3,
+			expectedTotalCount:  121141,
+			expectedTagIDs:      []string{".NET", ".NETCore"},
+		},
+		{
+			desc:         "success-default_sort",
+			inputPage:    1,
+			inputPerPage: 2,
+			inputSort:    TagSortDefault,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedPage:        1,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    100,
+			expectedNextPage:    2,
+			expectedTotalCount:  121141,
+			expectedTagIDs:      []string{"JavaScript", "Python"},
+		},
+		{
+			desc:         "failure-invalid_sort",
+			inputPage:    1,
+			inputPerPage: 2,
+			inputSort:    TagSort("followers"),
+
+			expectedErrString: "sort parameter should be",
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputPage:    101,
+			inputPerPage: 2,
+			inputSort:    TagSortCount,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags",
+			expectedRawQuery:    "page=101&per_page=2&sort=count",
+			expectedErrString:   "page parameter should be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			tagsResp, err := cli.GetTags(context.Background(), tt.inputPage, tt.inputPerPage, tt.inputSort)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedPage, tagsResp.Page)
+				assert.Equal(t, tt.expectedPerPage, tagsResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, tagsResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, tagsResp.LastPage)
+				assert.Equal(t, tt.expectedNextPage, tagsResp.NextPage)
+				assert.Equal(t, tt.expectedTotalCount, tagsResp.TotalCount)
+				var tagIDs []string
+				for _, tag := range tagsResp.Tags {
+					tagIDs = append(tagIDs, tag.ID)
+				}
+				assert.Equal(t, tt.expectedTagIDs, tagIDs)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetTagItems(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tags", "GetTagItems")
+
+	tests := []struct {
+		desc         string
+		inputTagID   string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+		expectedPage        int
+		expectedPerPage     int
+		expectedFirstPage   int
+		expectedLastPage    int
+		expectedTotalCount  int
+		expectedItemsLen    int
+	}{
+		{
+			desc:         "success",
+			inputTagID:   "Linux",
+			inputPage:    2,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/Linux/items",
+			expectedRawQuery:    "page=2&per_page=2",
+			expectedPage:        2,
+			expectedPerPage:     2,
+			expectedFirstPage:   1,
+			expectedLastPage:    100,
+			expectedTotalCount:  6811,
+			expectedItemsLen:    2,
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputTagID:   "Linux",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/Linux/items",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-not_exist",
+			inputTagID:   "nonexistent",
+			inputPage:    2,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/nonexistent/items",
+			expectedRawQuery:    "page=2&per_page=2",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			itemsResp, err := cli.GetTagItems(context.Background(), tt.inputTagID, tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedPage, itemsResp.Page)
+				assert.Equal(t, tt.expectedPerPage, itemsResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, itemsResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, itemsResp.LastPage)
+				assert.Equal(t, tt.expectedTotalCount, itemsResp.TotalCount)
+				assert.Equal(t, tt.expectedItemsLen, len(itemsResp.Items))
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_IsFollowingTag(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tags", "IsFollowingTag")
+
+	tests := []struct {
+		desc       string
+		inputTagID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedIsFollowing bool
+		expectedErrString   string
+	}{
+		{
+			desc:       "success-following",
+			inputTagID: "Go",
+
+			mockResponseHeaderFile: "following-header",
+			mockResponseBodyFile:   "following-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/Go/following",
+			expectedIsFollowing: true,
+		},
+		{
+			desc:       "success-not_following",
+			inputTagID: "Rust",
+
+			mockResponseHeaderFile: "not_following-header",
+			mockResponseBodyFile:   "not_following-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/Rust/following",
+			expectedIsFollowing: false,
+		},
+		{
+			desc:       "failure-not_exist",
+			inputTagID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/nonexistent/following",
+			expectedIsFollowing: false,
+		},
+		{
+			desc:       "failure-no_token",
+			inputTagID: "Go",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/tags/Go/following",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			isFollowing, err := cli.IsFollowingTag(context.Background(), tt.inputTagID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedIsFollowing, isFollowing)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+
+		})
+	}
+}
+func TestClient_FollowTag(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tags", "FollowTag")
+
+	tests := []struct {
+		desc       string
+		inputTagID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:       "success",
+			inputTagID: "Rust",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/tags/Rust/following",
+		},
+		{
+			desc:       "failure-already_following",
+			inputTagID: "Go",
+
+			mockResponseHeaderFile: "already_following-header",
+			mockResponseBodyFile:   "already_following-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/tags/Go/following",
+			expectedErrString:   "forbidden. you may already have followed",
+		},
+		{
+			desc:       "failure-not_exist",
+			inputTagID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/tags/nonexistent/following",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:       "failure-no_token",
+			inputTagID: "Rust",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPut,
+			expectedRequestPath: "/tags/Rust/following",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.FollowTag(context.Background(), tt.inputTagID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+
+		})
+	}
+}
+func TestClient_UnfollowTag(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tags", "UnfollowTag")
+
+	tests := []struct {
+		desc       string
+		inputTagID string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedRawQuery    string
+		expectedErrString   string
+	}{
+		{
+			desc:       "success",
+			inputTagID: "Go",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/tags/Go/following",
+		},
+		{
+			desc:       "failure-not_following",
+			inputTagID: "Rust",
+
+			mockResponseHeaderFile: "not_following-header",
+			mockResponseBodyFile:   "not_following-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/tags/Rust/following",
+			expectedErrString:   "forbidden. you may already have not followed",
+		},
+		{
+			desc:       "failure-not_exist",
+			inputTagID: "nonexistent",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/tags/nonexistent/following",
+			expectedErrString:   "not found",
+		},
+		{
+			desc:       "failure-no_token",
+			inputTagID: "Go",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/tags/Go/following",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			err := cli.UnfollowTag(context.Background(), tt.inputTagID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+
+		})
+	}
+}
+
+func TestClient_SyncFollowingTags(t *testing.T) {
+	tests := []struct {
+		desc         string
+		inputDesired []string
+		failTagID    string
+
+		expectedFollowed   []string
+		expectedUnfollowed []string
+		expectedRequests   []string
+		expectedErrString  string
+	}{
+		{
+			desc:         "success",
+			inputDesired: []string{"go", "TypeScript", "typescript", "Docker"},
+
+			expectedFollowed:   []string{"TypeScript", "Docker"},
+			expectedUnfollowed: []string{"React", "IntelliJ"},
+			expectedRequests: []string{
+				"PUT /tags/TypeScript/following",
+				"PUT /tags/Docker/following",
+				"DELETE /tags/React/following",
+				"DELETE /tags/IntelliJ/following",
+			},
+		},
+		{
+			desc:         "success-no_change",
+			inputDesired: []string{"Go", "React", "IntelliJ"},
+		},
+		{
+			desc:         "failure-not_exist",
+			inputDesired: []string{"Go", "TypeScript", "nonexistent", "Docker"},
+			failTagID:    "nonexistent",
+
+			expectedFollowed: []string{"TypeScript"},
+			expectedRequests: []string{
+				"PUT /tags/TypeScript/following",
+				"PUT /tags/nonexistent/following",
+			},
+			expectedErrString: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/authenticated_user":
+					_, _ = w.Write([]byte(`{"id":"muiscript"}`))
+				case req.Method == http.MethodGet && req.URL.Path == "/users/muiscript/following_tags":
+					w.Header().Set("link", `<https://qiita.com/api/v2/users/muiscript/following_tags?page=1&per_page=100>; rel="first", <https://qiita.com/api/v2/users/muiscript/following_tags?page=1&per_page=100>; rel="last"`)
+					w.Header().Set("total-count", "3")
+					_, _ = w.Write([]byte(`[{"id":"Go"},{"id":"React"},{"id":"IntelliJ"}]`))
+				default:
+					mu.Lock()
+					requests = append(requests, req.Method+" "+req.URL.Path)
+					mu.Unlock()
+
+					if req.URL.Path == path.Join("/tags", tt.failTagID, "following") {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message":"Not found","type":"not_found"}`))
+						return
+					}
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer server.Close()
+			cli := newPagedClient(t, server)
+
+			followed, unfollowed, err := cli.SyncFollowingTags(context.Background(), tt.inputDesired)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+			assert.Equal(t, tt.expectedFollowed, followed)
+			assert.Equal(t, tt.expectedUnfollowed, unfollowed)
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
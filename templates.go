@@ -0,0 +1,266 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+// Template represents a template of items on Qiita Team.
+// Expanded fields are the title, body and tags whose variables such as %{Year} are expanded at the time of the request.
+type Template struct {
+	ID       int        `json:"id"`
+	Name     string     `json:"name"`
+	Title    string     `json:"title"`
+	Body     string     `json:"body"`
+	ItemTags []*ItemTag `json:"tags"`
+
+	ExpandedTitle    string     `json:"expanded_title"`
+	ExpandedBody     string     `json:"expanded_body"`
+	ExpandedItemTags []*ItemTag `json:"expanded_tags"`
+}
+
+// TemplateDraft represents a template to be created or updated on Qiita Team.
+type TemplateDraft struct {
+	Name     string     `json:"name,omitempty"`
+	Title    string     `json:"title"`
+	Body     string     `json:"body"`
+	ItemTags []*ItemTag `json:"tags"`
+}
+
+// TemplatesResponse represents a response from qiita API which includes multiple templates.
+type TemplatesResponse struct {
+	Templates  []*Template
+	PerPage    int
+	Page       int
+	FirstPage  int
+	LastPage   int
+	NextPage   int
+	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
+}
+
+func newTemplatesResponse(templates []*Template, header http.Header, page, perPage int) (*TemplatesResponse, error) {
+	paginationInfo, err := extractPaginationInfo(header, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplatesResponse{
+		Templates:  templates,
+		PerPage:    paginationInfo.PerPage,
+		Page:       paginationInfo.Page,
+		FirstPage:  paginationInfo.FirstPage,
+		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
+		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
+	}, nil
+}
+
+// GetTemplates fetches the templates of the team.
+// This method requires authentication.
+//
+// GET /api/v2/templates
+// document: http://qiita.com/api/v2/docs#get-apiv2templates
+func (c *Client) GetTemplates(ctx context.Context, page, perPage int) (*TemplatesResponse, error) {
+	if err := validatePaginationLimit(page, perPage); err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, "templates", queries, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*Template
+	code, header, err := c.doRequest(req, &templates)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return newTemplatesResponse(templates, header, page, perPage)
+}
+
+// GetTemplate fetches the template having provided templateID.
+// This method requires authentication.
+//
+// GET /api/v2/templates/:template_id
+// document: http://qiita.com/api/v2/docs#get-apiv2templatestemplate_id
+func (c *Client) GetTemplate(ctx context.Context, templateID int) (*Template, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path.Join("templates", strconv.Itoa(templateID)), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template Template
+	code, _, err := c.doRequest(req, &template)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("template with id '%d' not found: %w", templateID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &template, nil
+}
+
+// CreateTemplate creates the template.
+// This method requires authentication.
+//
+// POST /api/v2/templates
+// document: http://qiita.com/api/v2/docs#post-apiv2templates
+func (c *Client) CreateTemplate(ctx context.Context, name, title, body string, itemTags []*ItemTag) (*Template, error) {
+	templateDraft := &TemplateDraft{Name: name, Title: title, Body: body, ItemTags: itemTags}
+	bodyBytes, err := json.Marshal(templateDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, "templates", nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var template Template
+	code, _, err := c.doRequest(req, &template)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &template, nil
+}
+
+// UpdateTemplate updates the template having provided templateID.
+// This method requires authentication.
+//
+// PATCH /api/v2/templates/:template_id
+// document: http://qiita.com/api/v2/docs#patch-apiv2templatestemplate_id
+func (c *Client) UpdateTemplate(ctx context.Context, templateID int, name, title, body string, itemTags []*ItemTag) (*Template, error) {
+	templateDraft := &TemplateDraft{Name: name, Title: title, Body: body, ItemTags: itemTags}
+	bodyBytes, err := json.Marshal(templateDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPatch, path.Join("templates", strconv.Itoa(templateID)), nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var template Template
+	code, _, err := c.doRequest(req, &template)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("template with id '%d' not found: %w", templateID, err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &template, nil
+}
+
+// DeleteTemplate deletes the template having provided templateID.
+// This method requires authentication.
+//
+// DELETE /api/v2/templates/:template_id
+// document: http://qiita.com/api/v2/docs#delete-apiv2templatestemplate_id
+func (c *Client) DeleteTemplate(ctx context.Context, templateID int) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("templates", strconv.Itoa(templateID)), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("template with id '%d' not found: %w", templateID, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExpandTemplate expands the variables such as %{Year} in provided title, body and tags of a template.
+// The result can be published with CreateItem.
+// This method requires authentication.
+//
+// POST /api/v2/expanded_templates
+// document: http://qiita.com/api/v2/docs#post-apiv2expanded_templates
+func (c *Client) ExpandTemplate(ctx context.Context, title, body string, itemTags []*ItemTag) (*ItemDraft, error) {
+	templateDraft := &TemplateDraft{Title: title, Body: body, ItemTags: itemTags}
+	bodyBytes, err := json.Marshal(templateDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	req, err := c.newRequest(ctx, http.MethodPost, "expanded_templates", nil, headers, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var expanded struct {
+		ExpandedTitle    string     `json:"expanded_title"`
+		ExpandedBody     string     `json:"expanded_body"`
+		ExpandedItemTags []*ItemTag `json:"expanded_tags"`
+	}
+	code, _, err := c.doRequest(req, &expanded)
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusForbidden:
+			return nil, fmt.Errorf("forbidden. some required field values may be empty or invalid: %w", err)
+		default:
+			return nil, err
+		}
+	}
+
+	return &ItemDraft{Title: expanded.ExpandedTitle, Body: expanded.ExpandedBody, ItemTags: expanded.ExpandedItemTags}, nil
+}
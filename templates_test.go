@@ -0,0 +1,541 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetTemplates(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "GetTemplates")
+
+	tests := []struct {
+		desc         string
+		inputPage    int
+		inputPerPage int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod        string
+		expectedRequestPath   string
+		expectedRawQuery      string
+		expectedErrString     string
+		expectedTemplatesLen  int
+		expectedFirstName     string
+		expectedPage          int
+		expectedPerPage       int
+		expectedFirstPage     int
+		expectedLastPage      int
+		expectedNextPage      int
+		expectedTotalCount    int
+		expectedRateRemaining int
+	}{
+		{
+			desc:         "success",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:        http.MethodGet,
+			expectedRequestPath:   "/templates",
+			expectedRawQuery:      "page=1&per_page=2",
+			expectedTemplatesLen:  2,
+			expectedFirstName:     "日報",
+			expectedPage:          1,
+			expectedPerPage:       2,
+			expectedFirstPage:     1,
+			expectedLastPage:      3,
+			expectedNextPage:      2,
+			expectedTotalCount:    5,
+			expectedRateRemaining: 999,
+		},
+		{
+			desc:         "failure-page_out_of_range",
+			inputPage:    101,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "out_of_range-header",
+			mockResponseBodyFile:   "out_of_range-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/templates",
+			expectedRawQuery:    "page=101&per_page=2",
+			expectedErrString:   "page parameter should be",
+		},
+		{
+			desc:         "failure-no_token",
+			inputPage:    1,
+			inputPerPage: 2,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/templates",
+			expectedRawQuery:    "page=1&per_page=2",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, tt.expectedRawQuery)
+			defer teardown()
+
+			templatesResp, err := cli.GetTemplates(context.Background(), tt.inputPage, tt.inputPerPage)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedTemplatesLen, len(templatesResp.Templates))
+				assert.Equal(t, tt.expectedFirstName, templatesResp.Templates[0].Name)
+				assert.Equal(t, tt.expectedPage, templatesResp.Page)
+				assert.Equal(t, tt.expectedPerPage, templatesResp.PerPage)
+				assert.Equal(t, tt.expectedFirstPage, templatesResp.FirstPage)
+				assert.Equal(t, tt.expectedLastPage, templatesResp.LastPage)
+				assert.Equal(t, tt.expectedNextPage, templatesResp.NextPage)
+				assert.Equal(t, tt.expectedTotalCount, templatesResp.TotalCount)
+				assert.Equal(t, tt.expectedRateRemaining, templatesResp.RateLimit.Remaining)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_GetTemplate(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "GetTemplate")
+
+	tests := []struct {
+		desc            string
+		inputTemplateID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod        string
+		expectedRequestPath   string
+		expectedErrString     string
+		expectedID            int
+		expectedName          string
+		expectedTitle         string
+		expectedExpandedTitle string
+		expectedTagName       string
+	}{
+		{
+			desc:            "success",
+			inputTemplateID: 1,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:        http.MethodGet,
+			expectedRequestPath:   "/templates/1",
+			expectedID:            1,
+			expectedName:          "日報",
+			expectedTitle:         "日報 %{Year}/%{month}/%{day}",
+			expectedExpandedTitle: "日報 2019/04/17",
+			expectedTagName:       "日報",
+		},
+		{
+			desc:            "failure-no_token",
+			inputTemplateID: 1,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/templates/1",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:            "failure-not_exist",
+			inputTemplateID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodGet,
+			expectedRequestPath: "/templates/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			template, err := cli.GetTemplate(context.Background(), tt.inputTemplateID)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, template.ID)
+				assert.Equal(t, tt.expectedName, template.Name)
+				assert.Equal(t, tt.expectedTitle, template.Title)
+				assert.Equal(t, tt.expectedExpandedTitle, template.ExpandedTitle)
+				assert.Equal(t, tt.expectedTagName, template.ItemTags[0].Name)
+				assert.Equal(t, tt.expectedTagName, template.ExpandedItemTags[0].Name)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_CreateTemplate(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "CreateTemplate")
+
+	tests := []struct {
+		desc          string
+		inputName     string
+		inputTitle    string
+		inputBody     string
+		inputItemTags []*ItemTag
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod        string
+		expectedRequestPath   string
+		expectedErrString     string
+		expectedID            int
+		expectedName          string
+		expectedExpandedTitle string
+	}{
+		{
+			desc:          "success",
+			inputName:     "議事録",
+			inputTitle:    "議事録 %{Year}/%{month}/%{day}",
+			inputBody:     "# 議題\n\n# 決定事項\n",
+			inputItemTags: []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:        http.MethodPost,
+			expectedRequestPath:   "/templates",
+			expectedID:            3,
+			expectedName:          "議事録",
+			expectedExpandedTitle: "議事録 2019/04/17",
+		},
+		{
+			desc:          "failure-empty_name",
+			inputName:     "",
+			inputTitle:    "議事録 %{Year}/%{month}/%{day}",
+			inputBody:     "# 議題\n\n# 決定事項\n",
+			inputItemTags: []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/templates",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:          "failure-no_token",
+			inputName:     "議事録",
+			inputTitle:    "議事録 %{Year}/%{month}/%{day}",
+			inputBody:     "# 議題\n\n# 決定事項\n",
+			inputItemTags: []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/templates",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			template, err := cli.CreateTemplate(context.Background(), tt.inputName, tt.inputTitle, tt.inputBody, tt.inputItemTags)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedID, template.ID)
+				assert.Equal(t, tt.expectedName, template.Name)
+				assert.Equal(t, tt.expectedExpandedTitle, template.ExpandedTitle)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_UpdateTemplate(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "UpdateTemplate")
+
+	tests := []struct {
+		desc            string
+		inputTemplateID int
+		inputName       string
+		inputTitle      string
+		inputBody       string
+		inputItemTags   []*ItemTag
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod        string
+		expectedRequestPath   string
+		expectedErrString     string
+		expectedName          string
+		expectedExpandedTitle string
+	}{
+		{
+			desc:            "success",
+			inputTemplateID: 3,
+			inputName:       "定例議事録",
+			inputTitle:      "定例議事録 %{Year}/%{month}/%{day}",
+			inputBody:       "# 議題\n\n# 決定事項\n",
+			inputItemTags:   []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:        http.MethodPatch,
+			expectedRequestPath:   "/templates/3",
+			expectedName:          "定例議事録",
+			expectedExpandedTitle: "定例議事録 2019/04/17",
+		},
+		{
+			desc:            "failure-empty_name",
+			inputTemplateID: 3,
+			inputName:       "",
+			inputTitle:      "定例議事録 %{Year}/%{month}/%{day}",
+			inputBody:       "# 議題\n\n# 決定事項\n",
+			inputItemTags:   []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "empty_field-header",
+			mockResponseBodyFile:   "empty_field-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/templates/3",
+			expectedErrString:   "forbidden",
+		},
+		{
+			desc:            "failure-no_token",
+			inputTemplateID: 3,
+			inputName:       "定例議事録",
+			inputTitle:      "定例議事録 %{Year}/%{month}/%{day}",
+			inputBody:       "# 議題\n\n# 決定事項\n",
+			inputItemTags:   []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/templates/3",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:            "failure-not_exist",
+			inputTemplateID: 999,
+			inputName:       "定例議事録",
+			inputTitle:      "定例議事録 %{Year}/%{month}/%{day}",
+			inputBody:       "# 議題\n\n# 決定事項\n",
+			inputItemTags:   []*ItemTag{{Name: "議事録"}},
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodPatch,
+			expectedRequestPath: "/templates/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			template, err := cli.UpdateTemplate(context.Background(), tt.inputTemplateID, tt.inputName, tt.inputTitle, tt.inputBody, tt.inputItemTags)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedName, template.Name)
+				assert.Equal(t, tt.expectedExpandedTitle, template.ExpandedTitle)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_DeleteTemplate(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "DeleteTemplate")
+
+	tests := []struct {
+		desc            string
+		inputTemplateID int
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+	}{
+		{
+			desc:            "success",
+			inputTemplateID: 3,
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/templates/3",
+		},
+		{
+			desc:            "failure-no_token",
+			inputTemplateID: 3,
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/templates/3",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:            "failure-not_exist",
+			inputTemplateID: 999,
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/templates/999",
+			expectedErrString:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			err := cli.DeleteTemplate(context.Background(), tt.inputTemplateID)
+			if tt.expectedErrString == "" {
+				assert.Nil(t, err)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
+
+func TestClient_ExpandTemplate(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "templates", "ExpandTemplate")
+
+	tests := []struct {
+		desc          string
+		inputTitle    string
+		inputBody     string
+		inputItemTags []*ItemTag
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+		expectedTitle       string
+		expectedBody        string
+		expectedTagName     string
+	}{
+		{
+			desc:          "success",
+			inputTitle:    "日報 %{Year}/%{month}/%{day}",
+			inputBody:     "# 今日やったこと\n\n# 明日やること\n",
+			inputItemTags: []*ItemTag{{Name: "日報"}},
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/expanded_templates",
+			expectedTitle:       "日報 2019/04/17",
+			expectedBody:        "# 今日やったこと\n\n# 明日やること\n",
+			expectedTagName:     "日報",
+		},
+		{
+			desc:          "failure-no_token",
+			inputTitle:    "日報 %{Year}/%{month}/%{day}",
+			inputBody:     "# 今日やったこと\n\n# 明日やること\n",
+			inputItemTags: []*ItemTag{{Name: "日報"}},
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodPost,
+			expectedRequestPath: "/expanded_templates",
+			expectedErrString:   "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			itemDraft, err := cli.ExpandTemplate(context.Background(), tt.inputTitle, tt.inputBody, tt.inputItemTags)
+			if tt.expectedErrString == "" {
+				if !assert.Nil(t, err) {
+					t.FailNow()
+				}
+
+				assert.Equal(t, tt.expectedTitle, itemDraft.Title)
+				assert.Equal(t, tt.expectedBody, itemDraft.Body)
+				assert.Equal(t, tt.expectedTagName, itemDraft.ItemTags[0].Name)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+			}
+		})
+	}
+}
@@ -25,7 +25,7 @@ func setup(t *testing.T, mockFilesBaseDir, mockResponseHeaderFile, mockResponseB
 	cli := &Client{
 		URL:        serverURL,
 		HTTPClient: server.Client(),
-		Logger:     log.New(ioutil.Discard, "", 0),
+		Logger:     NewStdLogger(log.New(ioutil.Discard, "", 0)),
 	}
 
 	teardown := func() {
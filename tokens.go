@@ -0,0 +1,56 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+// AccessToken represents an access token issued by qiita, with the client it is issued for and its scopes.
+type AccessToken struct {
+	ClientID string  `json:"client_id"`
+	Scopes   []Scope `json:"scopes"`
+	Token    string  `json:"token"`
+}
+
+// HasScope reports whether the access token is granted provided scope.
+func (t *AccessToken) HasScope(scope Scope) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// RevokeAccessToken revokes provided access token. Requests with the token fail after it is revoked.
+// This method requires authentication.
+//
+// DELETE /api/v2/access_tokens/:access_token
+// document: http://qiita.com/api/v2/docs#delete-apiv2access_tokensaccess_token
+func (c *Client) RevokeAccessToken(ctx context.Context, token string) error {
+	if token == "" {
+		return errors.New("access token should not be empty")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodDelete, path.Join("access_tokens", token), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	code, _, err := c.doRequest(req, &struct{}{})
+	if err != nil {
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("access token not found. it may already be revoked: %w", err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+// failingTransport is an http.RoundTripper which fails every request with err.
+type failingTransport struct {
+	err error
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+func TestAccessToken_HasScope(t *testing.T) {
+	token := &AccessToken{ClientID: "client", Scopes: []Scope{ScopeReadQiita, ScopeWriteQiita}, Token: "token"}
+
+	assert.True(t, token.HasScope(ScopeReadQiita))
+	assert.True(t, token.HasScope(ScopeWriteQiita))
+	assert.False(t, token.HasScope(ScopeReadQiitaTeam))
+}
+
+func TestClient_RevokeAccessToken(t *testing.T) {
+	mockFilesBaseDir := path.Join("testdata", "responses", "tokens", "RevokeAccessToken")
+
+	tests := []struct {
+		desc       string
+		inputToken string
+
+		mockResponseHeaderFile string
+		mockResponseBodyFile   string
+
+		expectedMethod      string
+		expectedRequestPath string
+		expectedErrString   string
+	}{
+		{
+			desc:       "success",
+			inputToken: "ea5d0a593b2655e9568f144fb1826342292f5c6b",
+
+			mockResponseHeaderFile: "success-header",
+			mockResponseBodyFile:   "success-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/access_tokens/ea5d0a593b2655e9568f144fb1826342292f5c6b",
+		},
+		{
+			desc:       "failure-no_token",
+			inputToken: "ea5d0a593b2655e9568f144fb1826342292f5c6b",
+
+			mockResponseHeaderFile: "no_token-header",
+			mockResponseBodyFile:   "no_token-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/access_tokens/ea5d0a593b2655e9568f144fb1826342292f5c6b",
+			expectedErrString:   "unauthorized",
+		},
+		{
+			desc:       "failure-not_exist",
+			inputToken: "ea5d0a593b2655e9568f144fb1826342292f5c6b",
+
+			mockResponseHeaderFile: "not_exist-header",
+			mockResponseBodyFile:   "not_exist-body",
+
+			expectedMethod:      http.MethodDelete,
+			expectedRequestPath: "/access_tokens/ea5d0a593b2655e9568f144fb1826342292f5c6b",
+			expectedErrString:   "access token not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			cli, teardown := setup(t, mockFilesBaseDir, tt.mockResponseHeaderFile, tt.mockResponseBodyFile, tt.expectedMethod, tt.expectedRequestPath, "")
+			defer teardown()
+
+			err := cli.RevokeAccessToken(context.Background(), tt.inputToken)
+			if tt.expectedErrString == "" {
+				assert.Nil(t, err)
+			} else {
+				if !assert.NotNil(t, err) {
+					t.FailNow()
+				}
+
+				assert.True(t, strings.Contains(err.Error(), tt.expectedErrString), fmt.Sprintf("'%s' should contain '%s'", err.Error(), tt.expectedErrString))
+				assert.False(t, strings.Contains(err.Error(), tt.inputToken), "error should not contain the access token")
+			}
+		})
+	}
+}
+
+func TestClient_RevokeAccessToken_TransportError(t *testing.T) {
+	const token = "ea5d0a593b2655e9568f144fb1826342292f5c6b"
+	transportErr := errors.New("connection refused")
+
+	cli, err := NewClient("", WithHTTPClient(&http.Client{Transport: &failingTransport{err: transportErr}}))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	err = cli.RevokeAccessToken(context.Background(), token)
+	if !assert.NotNil(t, err) {
+		t.FailNow()
+	}
+
+	assert.False(t, strings.Contains(err.Error(), token), err.Error())
+	assert.True(t, strings.Contains(err.Error(), "/access_tokens/%5BREDACTED%5D"), err.Error())
+	assert.True(t, errors.Is(err, transportErr))
+}
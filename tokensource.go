@@ -0,0 +1,152 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the access token sent with each request.
+// An empty token means that the request is sent without authentication.
+// Implementations should be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc adapts a function such as a callback of a secrets provider to TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// StaticTokenSource returns a TokenSource which always supplies provided token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+// EnvTokenSource returns a TokenSource which reads the token from the environment variable having provided name on each request.
+// It fails if the variable is not set.
+func EnvTokenSource(name string) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context) (string, error) {
+		token, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable '%s' is not set", name)
+		}
+		return strings.TrimSpace(token), nil
+	})
+}
+
+// FileTokenSource returns a TokenSource which reads the token from the file at provided path.
+// The file is read again when it is modified, so the token can be rotated by rewriting the file.
+func FileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func (s *fileTokenSource) Token(ctx context.Context) (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("access token file '%s' is empty", s.path)
+	}
+
+	s.token = token
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+	return token, nil
+}
+
+// ReuseTokenSource returns a TokenSource which reuses the token supplied by src for ttl,
+// which avoids calling a slow source such as a secrets provider on every request.
+func ReuseTokenSource(src TokenSource, ttl time.Duration) TokenSource {
+	return &reuseTokenSource{src: src, ttl: ttl, now: time.Now}
+}
+
+type reuseTokenSource struct {
+	src TokenSource
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func (s *reuseTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.expires.IsZero() && s.now().Before(s.expires) {
+		return s.token, nil
+	}
+
+	token, err := s.src.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	s.expires = s.now().Add(s.ttl)
+	return token, nil
+}
+
+// RotatingTokenSource supplies a token which can be replaced while requests are in flight.
+type RotatingTokenSource struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewRotatingTokenSource returns a RotatingTokenSource supplying provided token.
+func NewRotatingTokenSource(token string) *RotatingTokenSource {
+	return &RotatingTokenSource{token: token}
+}
+
+// Token returns the current token.
+func (s *RotatingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.token, nil
+}
+
+// Rotate replaces the token. Requests created after Rotate returns are sent with the new token.
+func (s *RotatingTokenSource) Rotate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+}
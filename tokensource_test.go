@@ -0,0 +1,189 @@
+package qiita
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEnvTokenSource(t *testing.T) {
+	const name = "QIITA_TEST_ACCESS_TOKEN"
+	defer func() {
+		_ = os.Unsetenv(name)
+	}()
+
+	ts := EnvTokenSource(name)
+
+	_ = os.Unsetenv(name)
+	_, err := ts.Token(context.Background())
+	if assert.NotNil(t, err) {
+		assert.True(t, strings.Contains(err.Error(), "is not set"), err.Error())
+	}
+
+	_ = os.Setenv(name, " token1\n")
+	token, err := ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "token1", token)
+
+	_ = os.Setenv(name, "token2")
+	token, err = ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "token2", token)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qiita-token")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	tokenPath := filepath.Join(dir, "token")
+
+	ts := FileTokenSource(tokenPath)
+
+	_, err = ts.Token(context.Background())
+	assert.NotNil(t, err)
+
+	writeToken := func(token string, modTime time.Time) {
+		if !assert.Nil(t, ioutil.WriteFile(tokenPath, []byte(token), 0600)) {
+			t.FailNow()
+		}
+		if !assert.Nil(t, os.Chtimes(tokenPath, modTime, modTime)) {
+			t.FailNow()
+		}
+	}
+
+	writeToken("token1\n", time.Date(2019, 4, 20, 0, 0, 0, 0, time.UTC))
+	token, err := ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "token1", token)
+
+	writeToken("token2\n", time.Date(2019, 4, 21, 0, 0, 0, 0, time.UTC))
+	token, err = ts.Token(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "token2", token)
+
+	writeToken("\n", time.Date(2019, 4, 22, 0, 0, 0, 0, time.UTC))
+	_, err = ts.Token(context.Background())
+	if assert.NotNil(t, err) {
+		assert.True(t, strings.Contains(err.Error(), "is empty"), err.Error())
+	}
+}
+
+func TestReuseTokenSource(t *testing.T) {
+	calls := 0
+	src := TokenSourceFunc(func(ctx context.Context) (string, error) {
+		calls++
+		return fmt.Sprintf("token%d", calls), nil
+	})
+
+	now := time.Date(2019, 4, 20, 0, 0, 0, 0, time.UTC)
+	ts := ReuseTokenSource(src, time.Minute).(*reuseTokenSource)
+	ts.now = func() time.Time { return now }
+
+	token, _ := ts.Token(context.Background())
+	assert.Equal(t, "token1", token)
+
+	now = now.Add(59 * time.Second)
+	token, _ = ts.Token(context.Background())
+	assert.Equal(t, "token1", token)
+
+	now = now.Add(time.Second)
+	token, _ = ts.Token(context.Background())
+	assert.Equal(t, "token2", token)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_TokenSource(t *testing.T) {
+	var mu sync.Mutex
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"id":"muiscript"}`))
+	}))
+	defer server.Close()
+
+	t.Run("rotate", func(t *testing.T) {
+		ts := NewRotatingTokenSource("token1")
+		cli, err := NewClient("ignored", WithBaseURL(server.URL), WithTokenSource(ts))
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, err := cli.GetUser(context.Background(), "muiscript")
+				assert.Nil(t, err)
+			}()
+			go func(i int) {
+				defer wg.Done()
+				ts.Rotate(fmt.Sprintf("token%d", i+2))
+			}(i)
+		}
+		wg.Wait()
+
+		ts.Rotate("rotated")
+		_, err = cli.GetUser(context.Background(), "muiscript")
+		assert.Nil(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, authorization := range authorizations[:len(authorizations)-1] {
+			assert.True(t, strings.HasPrefix(authorization, "Bearer token"), authorization)
+			assert.False(t, strings.Contains(authorization, "ignored"), authorization)
+		}
+		assert.Equal(t, "Bearer rotated", authorizations[len(authorizations)-1])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		cli, err := NewClient("", WithBaseURL(server.URL))
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+
+		_, err = cli.GetUser(context.Background(), "muiscript")
+		assert.Nil(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "", authorizations[len(authorizations)-1])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mu.Lock()
+		sent := len(authorizations)
+		mu.Unlock()
+
+		errSecrets := errors.New("secrets provider is unavailable")
+		cli, err := NewClient("", WithBaseURL(server.URL), WithTokenSource(TokenSourceFunc(func(ctx context.Context) (string, error) {
+			return "", errSecrets
+		})))
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+
+		_, err = cli.GetUser(context.Background(), "muiscript")
+		assert.True(t, errors.Is(err, errSecrets))
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, sent, len(authorizations))
+	})
+}
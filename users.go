@@ -29,6 +29,15 @@ type User struct {
 	TwitterID  string `json:"twitter_screen_name"`
 }
 
+// AuthenticatedUser represents the user associated with the access token,
+// which has the quota of image uploads in addition to the fields of User.
+type AuthenticatedUser struct {
+	User
+
+	ImageMonthlyUploadLimit     int `json:"image_monthly_upload_limit"`
+	ImageMonthlyUploadRemaining int `json:"image_monthly_upload_remaining"`
+}
+
 // UsersResponse represents a response from qiita API which includes multiple users.
 type UsersResponse struct {
 	Users      []*User
@@ -36,7 +45,10 @@ type UsersResponse struct {
 	Page       int
 	FirstPage  int
 	LastPage   int
+	NextPage   int
 	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
 }
 
 func newUsersResponse(users []*User, header http.Header, page, perPage int) (*UsersResponse, error) {
@@ -51,7 +63,10 @@ func newUsersResponse(users []*User, header http.Header, page, perPage int) (*Us
 		Page:       paginationInfo.Page,
 		FirstPage:  paginationInfo.FirstPage,
 		LastPage:   paginationInfo.LastPage,
+		NextPage:   paginationInfo.NextPage,
 		TotalCount: paginationInfo.TotalCount,
+		Truncated:  paginationInfo.Truncated,
+		RateLimit:  paginationInfo.RateLimit,
 	}, nil
 }
 
@@ -60,7 +75,10 @@ type paginationInfo struct {
 	Page       int
 	FirstPage  int
 	LastPage   int
+	NextPage   int
 	TotalCount int
+	Truncated  bool
+	RateLimit  *RateLimit
 }
 
 // GetUser fetches the user having provided userID.
@@ -76,17 +94,15 @@ func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
 	var user User
 	code, _, err := c.doRequest(req, &user)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return &user, nil
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return &user, nil
 }
 
 // GetUsers fetches all the users.
@@ -109,17 +125,12 @@ func (c *Client) GetUsers(ctx context.Context, page, perPage int) (*UsersRespons
 	}
 
 	var users []*User
-	code, header, err := c.doRequest(req, &users)
+	_, header, err := c.doRequest(req, &users)
 	if err != nil {
 		return nil, err
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newUsersResponse(users, header, page, perPage)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newUsersResponse(users, header, page, perPage)
 }
 
 // GetUserFollowees fetches all the followees of the user having provided userID.
@@ -144,17 +155,15 @@ func (c *Client) GetUserFollowees(ctx context.Context, userID string, page, perP
 	var users []*User
 	code, header, err := c.doRequest(req, &users)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newUsersResponse(users, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newUsersResponse(users, header, page, perPage)
 }
 
 // GetUserFollowers fetches all the followers of the user having provided userID.
@@ -179,20 +188,19 @@ func (c *Client) GetUserFollowers(ctx context.Context, userID string, page, perP
 	var users []*User
 	code, header, err := c.doRequest(req, &users)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newUsersResponse(users, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newUsersResponse(users, header, page, perPage)
 }
 
 // GetUserItems fetches the items created by the user having provided userID.
+// The items of the authenticated user are fetched if userID is empty, which requires authentication.
 //
 // GET /api/v2/users/:user_id/items
 // document: https://qiita.com/api/v2/docs#get-apiv2usersuser_iditems
@@ -201,6 +209,14 @@ func (c *Client) GetUserItems(ctx context.Context, userID string, page, perPage
 		return nil, err
 	}
 
+	if userID == "" {
+		user, err := c.Whoami(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	}
+
 	queries := map[string]string{
 		"page":     strconv.Itoa(page),
 		"per_page": strconv.Itoa(perPage),
@@ -213,17 +229,15 @@ func (c *Client) GetUserItems(ctx context.Context, userID string, page, perPage
 	var items []*Item
 	code, header, err := c.doRequest(req, &items)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newItemsResponse(items, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newItemsResponse(items, header, page, perPage)
 }
 
 // GetUserStocks fetches the items stocked by the user having provided userID.
@@ -247,17 +261,15 @@ func (c *Client) GetUserStocks(ctx context.Context, userID string, page, perPage
 	var items []*Item
 	code, header, err := c.doRequest(req, &items)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newItemsResponse(items, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newItemsResponse(items, header, page, perPage)
 }
 
 // GetUserFollowingTags fetches the tags followed by the user having provided userID.
@@ -281,17 +293,15 @@ func (c *Client) GetUserFollowingTags(ctx context.Context, userID string, page,
 	var tags []*Tag
 	code, header, err := c.doRequest(req, &tags)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("user with id '%s' not found: %w", userID, err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newTagsResponse(tags, header, page, perPage)
-	case http.StatusNotFound:
-		return nil, fmt.Errorf("user with id '%s' not found (status = %d)", userID, code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newTagsResponse(tags, header, page, perPage)
 }
 
 // IsFollowingUser returns true if the authenticated user is following the user having provided userID.
@@ -307,18 +317,17 @@ func (c *Client) IsFollowingUser(ctx context.Context, userID string) (bool, erro
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return false, err
-	}
-	switch code {
-	case http.StatusNoContent:
-		return true, nil
-	case http.StatusUnauthorized:
-		return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusNotFound:
-		return false, nil
-	default:
-		return false, fmt.Errorf("unknown error (status = %d)", code)
+		switch code {
+		case http.StatusUnauthorized:
+			return false, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return false, nil
+		default:
+			return false, err
+		}
 	}
+
+	return true, nil
 }
 
 // FollowUser follows the user having provided userID.
@@ -334,20 +343,19 @@ func (c *Client) FollowUser(ctx context.Context, userID string) error {
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return err
-	}
-	switch code {
-	case http.StatusNoContent:
-		return nil
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusNotFound:
-		return fmt.Errorf("not found. user with id '%s' does not exist (status = %d)", userID, code)
-	case http.StatusForbidden:
-		return fmt.Errorf("forbidden. you may already have followed user with id '%s' (status = %d)", userID, code)
-	default:
-		return fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("not found. user with id '%s' does not exist: %w", userID, err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have followed user with id '%s': %w", userID, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
 }
 
 // UnfollowUser unfollows the user having provided userID.
@@ -363,20 +371,19 @@ func (c *Client) UnfollowUser(ctx context.Context, userID string) error {
 
 	code, _, err := c.doRequest(req, &struct{}{})
 	if err != nil {
-		return err
-	}
-	switch code {
-	case http.StatusNoContent:
-		return nil
-	case http.StatusUnauthorized:
-		return fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	case http.StatusNotFound:
-		return fmt.Errorf("not found. user with id '%s' does not exist (status = %d)", userID, code)
-	case http.StatusForbidden:
-		return fmt.Errorf("forbidden. you may already have not followed user with id '%s' (status = %d)", userID, code)
-	default:
-		return fmt.Errorf("unknown error (status = %d)", code)
-	}
+		switch code {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		case http.StatusNotFound:
+			return fmt.Errorf("not found. user with id '%s' does not exist: %w", userID, err)
+		case http.StatusForbidden:
+			return fmt.Errorf("forbidden. you may already have not followed user with id '%s': %w", userID, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GetAuthenticatedUser returns the user who is associated with provided access token.
@@ -384,26 +391,55 @@ func (c *Client) UnfollowUser(ctx context.Context, userID string) error {
 //
 // GET /api/v2/authenticated_user
 // document: http://qiita.com/api/v2/docs#get-apiv2authenticated_user
-func (c *Client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+func (c *Client) GetAuthenticatedUser(ctx context.Context) (*AuthenticatedUser, error) {
 	req, err := c.newRequest(ctx, http.MethodGet, path.Join("authenticated_user"), nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var user User
+	var user AuthenticatedUser
 	code, _, err := c.doRequest(req, &user)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return &user, nil
-	case http.StatusUnauthorized:
-		return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return &user, nil
+}
+
+// Whoami returns the user associated with the access token.
+// The user is fetched by GetAuthenticatedUser only on the first call and cached on the client until the access token changes,
+// so the quota of image uploads in it may be outdated. Use GetAuthenticatedUser to get the latest one.
+// This method requires authentication.
+func (c *Client) Whoami(ctx context.Context) (*AuthenticatedUser, error) {
+	var token string
+	if c.TokenSource != nil {
+		var err error
+		token, err = c.TokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token: %w", err)
+		}
+	}
+
+	c.whoamiMu.Lock()
+	defer c.whoamiMu.Unlock()
+
+	if c.whoami == nil || c.whoamiToken != token {
+		user, err := c.GetAuthenticatedUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.whoami = user
+		c.whoamiToken = token
+	}
+
+	// the cached user is copied so that callers cannot modify it.
+	user := *c.whoami
+	return &user, nil
 }
 
 // GetAuthenticatedUserItems fetches the item created by the authenticated user.
@@ -428,15 +464,13 @@ func (c *Client) GetAuthenticatedUserItems(ctx context.Context, page, perPage in
 	var items []*Item
 	code, header, err := c.doRequest(req, &items)
 	if err != nil {
-		return nil, err
+		switch code {
+		case http.StatusUnauthorized:
+			return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token: %w", err)
+		default:
+			return nil, err
+		}
 	}
 
-	switch code {
-	case http.StatusOK:
-		return newItemsResponse(items, header, page, perPage)
-	case http.StatusUnauthorized:
-		return nil, fmt.Errorf("unauthorized. you may have provided no/invalid access token (status = %d)", code)
-	default:
-		return nil, fmt.Errorf("unknown error (status = %d)", code)
-	}
+	return newItemsResponse(items, header, page, perPage)
 }
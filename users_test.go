@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -928,15 +930,17 @@ func TestClient_GetAuthenticatedUser(t *testing.T) {
 		mockResponseHeaderFile string
 		mockResponseBodyFile   string
 
-		expectedMethod         string
-		expectedRequestPath    string
-		expectedRawQuery       string
-		expectedErrString      string
-		expectedID             string
-		expectedPermanentID    int
-		expectedGithubID       string
-		expectedPostsCount     int
-		expectedFollowersCount int
+		expectedMethod          string
+		expectedRequestPath     string
+		expectedRawQuery        string
+		expectedErrString       string
+		expectedID              string
+		expectedPermanentID     int
+		expectedGithubID        string
+		expectedPostsCount      int
+		expectedFollowersCount  int
+		expectedUploadLimit     int
+		expectedUploadRemaining int
 	}{
 		{
 			desc: "success",
@@ -944,13 +948,15 @@ func TestClient_GetAuthenticatedUser(t *testing.T) {
 			mockResponseHeaderFile: "success-header",
 			mockResponseBodyFile:   "success-body",
 
-			expectedMethod:         http.MethodGet,
-			expectedRequestPath:    "/authenticated_user",
-			expectedID:             "muiscript",
-			expectedPermanentID:    159260,
-			expectedGithubID:       "muiscript",
-			expectedPostsCount:     14,
-			expectedFollowersCount: 12,
+			expectedMethod:          http.MethodGet,
+			expectedRequestPath:     "/authenticated_user",
+			expectedID:              "muiscript",
+			expectedPermanentID:     159260,
+			expectedGithubID:        "muiscript",
+			expectedPostsCount:      14,
+			expectedFollowersCount:  12,
+			expectedUploadLimit:     104857600,
+			expectedUploadRemaining: 104857600,
 		},
 		{
 			desc: "failure-no_token",
@@ -981,6 +987,8 @@ func TestClient_GetAuthenticatedUser(t *testing.T) {
 				assert.Equal(t, tt.expectedGithubID, user.GithubID)
 				assert.Equal(t, tt.expectedPostsCount, user.PostsCount)
 				assert.Equal(t, tt.expectedFollowersCount, user.FollowersCount)
+				assert.Equal(t, tt.expectedUploadLimit, user.ImageMonthlyUploadLimit)
+				assert.Equal(t, tt.expectedUploadRemaining, user.ImageMonthlyUploadRemaining)
 			} else {
 				if !assert.NotNil(t, err) {
 					t.FailNow()
@@ -993,6 +1001,109 @@ func TestClient_GetAuthenticatedUser(t *testing.T) {
 	}
 }
 
+func TestClient_Whoami(t *testing.T) {
+	body := parseBody(t, path.Join("testdata", "responses", "users", "GetAuthenticatedUser", "success-body"))
+
+	var mu sync.Mutex
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !assert.Equal(t, "/authenticated_user", req.URL.Path) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		mu.Unlock()
+
+		if req.Header.Get("Authorization") == "Bearer invalid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Unauthorized","type":"unauthorized"}`))
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	ts := NewRotatingTokenSource("token1")
+	cli, err := NewClient("", WithBaseURL(server.URL), WithTokenSource(ts))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			user, err := cli.Whoami(context.Background())
+			if assert.Nil(t, err) {
+				assert.Equal(t, "muiscript", user.ID)
+				assert.Equal(t, 104857600, user.ImageMonthlyUploadLimit)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []string{"Bearer token1"}, authorizations)
+
+	user, _ := cli.Whoami(context.Background())
+	user.ID = "modified"
+	user, _ = cli.Whoami(context.Background())
+	assert.Equal(t, "muiscript", user.ID)
+
+	ts.Rotate("invalid")
+	_, err = cli.Whoami(context.Background())
+	if assert.NotNil(t, err) {
+		assert.True(t, strings.Contains(err.Error(), "unauthorized"), err.Error())
+	}
+
+	ts.Rotate("token2")
+	_, err = cli.Whoami(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Bearer token1", "Bearer invalid", "Bearer token2"}, authorizations)
+}
+
+func TestClient_GetUserItems_AuthenticatedUser(t *testing.T) {
+	userBody := parseBody(t, path.Join("testdata", "responses", "users", "GetAuthenticatedUser", "success-body"))
+	itemsHeaderPath := path.Join("testdata", "responses", "users", "GetUserItems", "success-header")
+	itemsBody := parseBody(t, path.Join("testdata", "responses", "users", "GetUserItems", "success-body"))
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.URL.Path)
+
+		switch req.URL.Path {
+		case "/authenticated_user":
+			_, _ = w.Write(userBody)
+		case "/users/muiscript/items":
+			statusCode, kvs := parseHeader(t, itemsHeaderPath)
+			for k, v := range kvs {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(statusCode)
+			_, _ = w.Write(itemsBody)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	cli, err := NewClient("token", WithBaseURL(server.URL))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	for i := 0; i < 2; i++ {
+		itemsResp, err := cli.GetUserItems(context.Background(), "", 1, 2)
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		assert.NotEmpty(t, itemsResp.Items)
+	}
+	assert.Equal(t, []string{"/authenticated_user", "/users/muiscript/items", "/users/muiscript/items"}, requests)
+}
+
 func TestClient_GetAuthenticatedUserItems(t *testing.T) {
 	mockFilesBaseDir := path.Join("testdata", "responses", "users", "GetAuthenticatedUserItems")
 